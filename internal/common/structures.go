@@ -3,6 +3,7 @@ package common
 import (
 	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
@@ -237,3 +238,33 @@ func ReverseList[T any](list []T) []T {
 
 	return list
 }
+
+// MostRecent narrows the list down to the element whose timestamp, as returned by timeOf, is the latest.
+// Timestamps are expected in the ISO8601 form used by the Cloud DB APIs (e.g. 2024-01-02T03:04:05+0900).
+func MostRecent[T any](list []*T, timeOf func(*T) *string) []*T {
+	var latest *T
+	var latestTime time.Time
+
+	for _, v := range list {
+		t := timeOf(v)
+		if t == nil {
+			continue
+		}
+
+		parsed, err := time.Parse("2006-01-02T15:04:05Z0700", *t)
+		if err != nil {
+			continue
+		}
+
+		if latest == nil || parsed.After(latestTime) {
+			latest = v
+			latestTime = parsed
+		}
+	}
+
+	if latest == nil {
+		return nil
+	}
+
+	return []*T{latest}
+}
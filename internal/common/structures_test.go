@@ -457,3 +457,32 @@ func TestExpandSourceBuildEnvVarsParams(t *testing.T) {
 		t.Fatalf("expected result value2, but got %s", *env2.Value)
 	}
 }
+
+func TestMostRecent(t *testing.T) {
+	type backup struct {
+		Name    string
+		EndTime *string
+	}
+
+	backups := []*backup{
+		{Name: "first", EndTime: ncloud.String("2024-01-01T03:00:00+0900")},
+		{Name: "latest", EndTime: ncloud.String("2024-01-03T03:00:00+0900")},
+		{Name: "invalid", EndTime: ncloud.String("not-a-date")},
+		{Name: "running", EndTime: nil},
+		{Name: "second", EndTime: ncloud.String("2024-01-02T03:00:00+0900")},
+	}
+
+	result := MostRecent(backups, func(b *backup) *string { return b.EndTime })
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	if result[0].Name != "latest" {
+		t.Fatalf("expected result latest, but got %s", result[0].Name)
+	}
+
+	if len(MostRecent([]*backup{}, func(b *backup) *string { return b.EndTime })) != 0 {
+		t.Fatal("expected empty result for empty list")
+	}
+}
@@ -55,3 +55,9 @@ func genEndpointWithCode(region, site string) string {
 
 	return s3Endpoint
 }
+
+// GenObjectStorageDomain returns the object storage host name of the site and region.
+// A bucket is reached through the virtual-hosted "<bucket_name>.<domain>".
+func GenObjectStorageDomain(region, site string) string {
+	return strings.TrimPrefix(genEndpointWithCode(region, site), "https://")
+}
@@ -90,10 +90,12 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, mysql.NewMysqlProductsDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlUsersDataSource)
 	dataSources = append(dataSources, mysql.NewMysqlDatabasesDataSource)
+	dataSources = append(dataSources, mysql.NewMysqlBackupsDataSource)
 	dataSources = append(dataSources, mongodb.NewMongoDbDataSource)
 	dataSources = append(dataSources, mongodb.NewMongoDbProductsDataSource)
 	dataSources = append(dataSources, mongodb.NewMongoDbImageProductsDataSource)
 	dataSources = append(dataSources, mongodb.NewMongoDbUsersDataSource)
+	dataSources = append(dataSources, mongodb.NewMongoDbBackupsDataSource)
 	dataSources = append(dataSources, hadoop.NewHadoopDataSource)
 	dataSources = append(dataSources, hadoop.NewHadoopAddOnDataSource)
 	dataSources = append(dataSources, hadoop.NewHadoopBucketDataSource)
@@ -103,17 +105,21 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 	dataSources = append(dataSources, redis.NewRedisDataSource)
 	dataSources = append(dataSources, redis.NewRedisImageProductsDataSource)
 	dataSources = append(dataSources, redis.NewRedisProductsDataSource)
+	dataSources = append(dataSources, redis.NewRedisBackupsDataSource)
 	dataSources = append(dataSources, mssql.NewMssqlDataSource)
 	dataSources = append(dataSources, mssql.NewMssqlImageProductsDataSource)
 	dataSources = append(dataSources, mssql.NewMssqlProductsDataSource)
+	dataSources = append(dataSources, mssql.NewMssqlBackupsDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlProductsDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlImageProductsDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlDatabasesDataSource)
 	dataSources = append(dataSources, postgresql.NewPostgresqlUsersDataSource)
+	dataSources = append(dataSources, postgresql.NewPostgresqlBackupsDataSource)
 	dataSources = append(dataSources, loadbalancer.NewLoadBalancerDataSource)
 	dataSources = append(dataSources, objectstorage.NewBucketDataSource)
 	dataSources = append(dataSources, objectstorage.NewObjectDataSource)
+	dataSources = append(dataSources, objectstorage.NewPolicyDocumentDataSource)
 
 	if err := errs.ErrorOrNil(); err != nil {
 		tflog.Warn(ctx, "registering resources", map[string]interface{}{
@@ -139,22 +145,36 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	resources = append(resources, mysql.NewMysqlRecoveryResource)
 	resources = append(resources, mysql.NewMysqlDatabasesResource)
 	resources = append(resources, mysql.NewMysqlSlaveResource)
+	resources = append(resources, mysql.NewMysqlUserResource)
+	resources = append(resources, mysql.NewMysqlDatabaseResource)
+	resources = append(resources, mysql.NewMysqlLogExportResource)
 	resources = append(resources, mongodb.NewMongoDbResource)
 	resources = append(resources, mongodb.NewMongoDbUsersResource)
 	resources = append(resources, hadoop.NewHadoopResource)
 	resources = append(resources, redis.NewRedisConfigGroupResource)
 	resources = append(resources, redis.NewRedisResource)
+	resources = append(resources, redis.NewRedisUsersResource)
 	resources = append(resources, mssql.NewMssqlResource)
 	resources = append(resources, postgresql.NewPostgresqlResource)
 	resources = append(resources, postgresql.NewPostgresqlReadReplicaResource)
 	resources = append(resources, postgresql.NewPostgresqlDatabasesResource)
 	resources = append(resources, postgresql.NewPostgresqlUsersResource)
+	resources = append(resources, postgresql.NewPostgresqlUserResource)
+	resources = append(resources, postgresql.NewPostgresqlDatabaseResource)
+	resources = append(resources, postgresql.NewPostgresqlLogExportResource)
 	resources = append(resources, loadbalancer.NewLbResource)
 	resources = append(resources, objectstorage.NewBucketResource)
 	resources = append(resources, objectstorage.NewObjectResource)
 	resources = append(resources, objectstorage.NewObjectACLResource)
 	resources = append(resources, objectstorage.NewBucketACLResource)
+	resources = append(resources, objectstorage.NewBucketVersioningResource)
+	resources = append(resources, objectstorage.NewBucketLifecycleConfigurationResource)
+	resources = append(resources, objectstorage.NewBucketCORSConfigurationResource)
+	resources = append(resources, objectstorage.NewBucketPolicyResource)
+	resources = append(resources, objectstorage.NewBucketWebsiteConfigurationResource)
+	resources = append(resources, objectstorage.NewBucketServerSideEncryptionConfigurationResource)
 	resources = append(resources, objectstorage.NewObjectCopyResource)
+	resources = append(resources, objectstorage.NewDirectoryResource)
 
 	if err := errs.ErrorOrNil(); err != nil {
 		tflog.Warn(ctx, "registering resources", map[string]interface{}{
@@ -3,6 +3,7 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -35,6 +36,7 @@ var (
 	_ resource.Resource                = &mongodbResource{}
 	_ resource.ResourceWithConfigure   = &mongodbResource{}
 	_ resource.ResourceWithImportState = &mongodbResource{}
+	_ resource.ResourceWithModifyPlan  = &mongodbResource{}
 )
 
 func NewMongoDbResource() resource.Resource {
@@ -327,6 +329,12 @@ func (m *mongodbResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"connection_uri": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"access_control_group_no_list": schema.ListAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
@@ -752,6 +760,28 @@ func (m *mongodbResource) Update(ctx context.Context, req resource.UpdateRequest
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
+func (m *mongodbResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state mongodbResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Hosts of connection_uri change along with the servers
+	if !plan.MongosServerCount.Equal(state.MongosServerCount) || !plan.MemberServerCount.Equal(state.MemberServerCount) ||
+		!plan.ArbiterServerCount.Equal(state.ArbiterServerCount) || !plan.ShardCount.Equal(state.ShardCount) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connection_uri"), types.StringUnknown())...)
+	}
+}
+
 func (m *mongodbResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state mongodbResourceModel
 
@@ -956,6 +986,7 @@ type mongodbResourceModel struct {
 	EngineVersionCode         types.String `tfsdk:"engine_version_code"`
 	RegionCode                types.String `tfsdk:"region_code"`
 	ZoneCode                  types.String `tfsdk:"zone_code"`
+	ConnectionUri             types.String `tfsdk:"connection_uri"`
 	AccessControlGroupNoList  types.List   `tfsdk:"access_control_group_no_list"`
 	MongoDbServerList         types.List   `tfsdk:"mongodb_server_list"`
 }
@@ -1080,5 +1111,48 @@ func (m *mongodbResourceModel) refreshFromOutput(ctx context.Context, output *vm
 	mongoServers, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mongoServer{}.attrTypes()}, serverList)
 
 	m.MongoDbServerList = mongoServers
+	m.refreshConnectionUri(output)
+}
+
+// refreshConnectionUri lists the mongos routers of a sharded cluster, or the members of a replica set, as the hosts to connect to.
+func (m *mongodbResourceModel) refreshConnectionUri(output *vmongodb.CloudMongoDbInstance) {
+	m.ConnectionUri = types.StringNull()
+
+	clusterType := common.GetCodePtrByCommonCode(output.ClusterType)
+	sharded := clusterType != nil && *clusterType == "SHARDED_CLUSTER"
+
+	var hosts []string
+	var replicaSetName string
+	for _, server := range output.CloudMongoDbServerInstanceList {
+		role := common.GetCodePtrByCommonCode(server.CloudMongoDbServerRole)
+		if role == nil || server.PrivateDomain == nil {
+			continue
+		}
+
+		if sharded && *role == "RT" && output.MongosPort != nil {
+			hosts = append(hosts, fmt.Sprintf("%s:%d", *server.PrivateDomain, *output.MongosPort))
+		} else if !sharded && (*role == "A" || *role == "MB") && output.MemberPort != nil {
+			hosts = append(hosts, fmt.Sprintf("%s:%d", *server.PrivateDomain, *output.MemberPort))
+			if server.ReplicaSetName != nil {
+				replicaSetName = *server.ReplicaSetName
+			}
+		}
+	}
 
+	if len(hosts) == 0 {
+		return
+	}
+
+	uri := url.URL{
+		Scheme: "mongodb",
+		Host:   strings.Join(hosts, ","),
+		Path:   "/",
+	}
+	if !m.UserName.IsNull() && !m.UserName.IsUnknown() {
+		uri.User = url.User(m.UserName.ValueString())
+	}
+	if replicaSetName != "" {
+		uri.RawQuery = url.Values{"replicaSet": []string{replicaSetName}}.Encode()
+	}
+	m.ConnectionUri = types.StringValue(uri.String())
 }
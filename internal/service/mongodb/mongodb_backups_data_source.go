@@ -0,0 +1,277 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmongodb"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &mongodbBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &mongodbBackupsDataSource{}
+)
+
+func NewMongoDbBackupsDataSource() datasource.DataSource {
+	return &mongodbBackupsDataSource{}
+}
+
+type mongodbBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *mongodbBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mongodb_backups"
+}
+
+func (d *mongodbBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *mongodbBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("mongodb_instance_no"),
+					),
+				},
+			},
+			"mongodb_instance_no": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("id"),
+					),
+				},
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"mongodb_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"data_storage_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"backup_parallel": schema.Int64Attribute{
+							Computed: true,
+						},
+						"shard": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *mongodbBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mongodbBackupsDataSourceModel
+	var mongodbId string
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		mongodbId = data.ID.ValueString()
+	}
+
+	if !data.MongodbInstanceNo.IsNull() && !data.MongodbInstanceNo.IsUnknown() {
+		mongodbId = data.MongodbInstanceNo.ValueString()
+	}
+
+	output, err := GetMongoDbBackupDetailList(ctx, d.config, mongodbId)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	mongodbBackupList := flattenMongoDbBackups(output)
+	fillteredList := common.FilterModels(ctx, data.Filters, mongodbBackupList)
+
+	if data.MostRecent.ValueBool() {
+		fillteredList = common.MostRecent(fillteredList, func(b *mongodbBackup) *string {
+			return b.EndTime.ValueStringPointer()
+		})
+	}
+
+	if diags := data.refreshFromOutput(ctx, fillteredList, mongodbId); diags.HasError() {
+		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		outputPath := data.OutputFile.ValueString()
+
+		if convertedList, err := convertBackupsToJsonStruct(data.MongodbBackupList.Elements()); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		} else if err := common.WriteToFile(outputPath, convertedList); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func GetMongoDbBackupDetailList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vmongodb.CloudMongoDbBackupDetail, error) {
+	reqParams := &vmongodb.GetCloudMongoDbBackupDetailListRequest{
+		RegionCode:             &config.RegionCode,
+		CloudMongoDbInstanceNo: ncloud.String(id),
+	}
+	tflog.Info(ctx, "GetMongoDbBackupDetailList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vmongodb.V2Api.GetCloudMongoDbBackupDetailList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "GetMongoDbBackupDetailList response="+common.MarshalUncheckedString(resp))
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.CloudMongoDbBackupDetailList, nil
+}
+
+type mongodbBackupsDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	MongodbInstanceNo types.String `tfsdk:"mongodb_instance_no"`
+	MostRecent        types.Bool   `tfsdk:"most_recent"`
+	MongodbBackupList types.List   `tfsdk:"mongodb_backup_list"`
+	OutputFile        types.String `tfsdk:"output_file"`
+	Filters           types.Set    `tfsdk:"filter"`
+}
+
+type mongodbBackup struct {
+	StartTime       types.String `tfsdk:"start_time"`
+	EndTime         types.String `tfsdk:"end_time"`
+	BackupSize      types.Int64  `tfsdk:"backup_size"`
+	DataStorageSize types.Int64  `tfsdk:"data_storage_size"`
+	BackupParallel  types.Int64  `tfsdk:"backup_parallel"`
+	Shard           types.String `tfsdk:"shard"`
+}
+
+type mongodbBackupToJsonConvert struct {
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	BackupSize      int64  `json:"backup_size"`
+	DataStorageSize int64  `json:"data_storage_size"`
+	BackupParallel  int64  `json:"backup_parallel"`
+	Shard           string `json:"shard"`
+}
+
+func (r mongodbBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_time":        types.StringType,
+		"end_time":          types.StringType,
+		"backup_size":       types.Int64Type,
+		"data_storage_size": types.Int64Type,
+		"backup_parallel":   types.Int64Type,
+		"shard":             types.StringType,
+	}
+}
+
+func convertBackupsToJsonStruct(backups []attr.Value) ([]mongodbBackupToJsonConvert, error) {
+	var backupToConvert = []mongodbBackupToJsonConvert{}
+
+	for _, backup := range backups {
+		backupJson := mongodbBackupToJsonConvert{}
+		if err := json.Unmarshal([]byte(backup.String()), &backupJson); err != nil {
+			return nil, err
+		}
+		backupToConvert = append(backupToConvert, backupJson)
+	}
+
+	return backupToConvert, nil
+}
+
+func flattenMongoDbBackups(list []*vmongodb.CloudMongoDbBackupDetail) []*mongodbBackup {
+	var outputs []*mongodbBackup
+
+	for _, v := range list {
+		var output mongodbBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *mongodbBackup) refreshFromOutput(output *vmongodb.CloudMongoDbBackupDetail) {
+	d.StartTime = types.StringPointerValue(output.StartTime)
+	d.EndTime = types.StringPointerValue(output.EndTime)
+	d.BackupSize = types.Int64PointerValue(output.BackupSize)
+	d.DataStorageSize = types.Int64PointerValue(output.DataStorageSize)
+	d.BackupParallel = common.Int64ValueFromInt32(output.BackupParallel)
+	d.Shard = types.StringPointerValue(output.Shard)
+}
+
+func (d *mongodbBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*mongodbBackup, instance string) diag.Diagnostics {
+	d.ID = types.StringValue(instance)
+	d.MongodbInstanceNo = types.StringValue(instance)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mongodbBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.MongodbBackupList = backupListValue
+
+	return diags
+}
@@ -0,0 +1,63 @@
+package mongodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudMongoDbBackups_vpc_basic(t *testing.T) {
+	dataName := "data.ncloud_mongodb_backups.all"
+	resourceName := "ncloud_mongodb.mongodb"
+	testMongoDbName := fmt.Sprintf("tf-mongobk-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMongoDbBackupsConfig(testMongoDbName),
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttrPair(dataName, "id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMongoDbBackupsConfig(testMongoDbName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.0.0.0/16"
+}
+
+resource "ncloud_subnet" "subnet" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.0.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mongodb" "mongodb" {
+	vpc_no = ncloud_vpc.vpc.vpc_no
+	subnet_no = ncloud_subnet.subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "ex-svr"
+	user_name = "testuser"
+	user_password = "t123456789!"
+	cluster_type_code = "STAND_ALONE"
+}
+
+data "ncloud_mongodb_backups" "all" {
+	mongodb_instance_no = ncloud_mongodb.mongodb.id
+	most_recent = true
+}
+`, testMongoDbName)
+}
@@ -39,6 +39,7 @@ func TestAccResourceNcloudMongoDb_vpc_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "user_password", "t123456789!"),
 					resource.TestCheckResourceAttr(resourceName, "backup_time", "02:00"),
 					resource.TestCheckResourceAttr(resourceName, "backup_file_retention_period", "1"),
+					resource.TestMatchResourceAttr(resourceName, "connection_uri", regexp.MustCompile(`^mongodb://testuser@`)),
 					resource.TestCheckResourceAttr(resourceName, "cluster_type_code", "STAND_ALONE"),
 				),
 			},
@@ -0,0 +1,291 @@
+package mssql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmssql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &mssqlBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &mssqlBackupsDataSource{}
+)
+
+func NewMssqlBackupsDataSource() datasource.DataSource {
+	return &mssqlBackupsDataSource{}
+}
+
+type mssqlBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *mssqlBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mssql_backups"
+}
+
+func (d *mssqlBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *mssqlBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("mssql_instance_no"),
+					),
+				},
+			},
+			"mssql_instance_no": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("id"),
+					),
+				},
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"mssql_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"database_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"server_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"backup_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"first_lsn": schema.StringAttribute{
+							Computed: true,
+						},
+						"last_lsn": schema.StringAttribute{
+							Computed: true,
+						},
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *mssqlBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mssqlBackupsDataSourceModel
+	var mssqlId string
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		mssqlId = data.ID.ValueString()
+	}
+
+	if !data.MssqlInstanceNo.IsNull() && !data.MssqlInstanceNo.IsUnknown() {
+		mssqlId = data.MssqlInstanceNo.ValueString()
+	}
+
+	output, err := GetMssqlBackupFileList(ctx, d.config, mssqlId)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	mssqlBackupList := flattenMssqlBackups(output)
+	fillteredList := common.FilterModels(ctx, data.Filters, mssqlBackupList)
+
+	if data.MostRecent.ValueBool() {
+		fillteredList = common.MostRecent(fillteredList, func(b *mssqlBackup) *string {
+			return b.EndTime.ValueStringPointer()
+		})
+	}
+
+	if diags := data.refreshFromOutput(ctx, fillteredList, mssqlId); diags.HasError() {
+		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		outputPath := data.OutputFile.ValueString()
+
+		if convertedList, err := convertBackupsToJsonStruct(data.MssqlBackupList.Elements()); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		} else if err := common.WriteToFile(outputPath, convertedList); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func GetMssqlBackupFileList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vmssql.BackupFile, error) {
+	reqParams := &vmssql.GetDmsBackupListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudMssqlInstanceNo: ncloud.String(id),
+	}
+	tflog.Info(ctx, "GetDmsBackupList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vmssql.V2Api.GetDmsBackupList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "GetDmsBackupList response="+common.MarshalUncheckedString(resp))
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.BackupFileList, nil
+}
+
+type mssqlBackupsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	MssqlInstanceNo types.String `tfsdk:"mssql_instance_no"`
+	MostRecent      types.Bool   `tfsdk:"most_recent"`
+	MssqlBackupList types.List   `tfsdk:"mssql_backup_list"`
+	OutputFile      types.String `tfsdk:"output_file"`
+	Filters         types.Set    `tfsdk:"filter"`
+}
+
+type mssqlBackup struct {
+	FileName     types.String `tfsdk:"file_name"`
+	DatabaseName types.String `tfsdk:"database_name"`
+	ServerName   types.String `tfsdk:"server_name"`
+	BackupType   types.String `tfsdk:"backup_type"`
+	FirstLsn     types.String `tfsdk:"first_lsn"`
+	LastLsn      types.String `tfsdk:"last_lsn"`
+	StartTime    types.String `tfsdk:"start_time"`
+	EndTime      types.String `tfsdk:"end_time"`
+}
+
+type mssqlBackupToJsonConvert struct {
+	FileName     string `json:"file_name"`
+	DatabaseName string `json:"database_name"`
+	ServerName   string `json:"server_name"`
+	BackupType   string `json:"backup_type"`
+	FirstLsn     string `json:"first_lsn"`
+	LastLsn      string `json:"last_lsn"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+}
+
+func (r mssqlBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"file_name":     types.StringType,
+		"database_name": types.StringType,
+		"server_name":   types.StringType,
+		"backup_type":   types.StringType,
+		"first_lsn":     types.StringType,
+		"last_lsn":      types.StringType,
+		"start_time":    types.StringType,
+		"end_time":      types.StringType,
+	}
+}
+
+func convertBackupsToJsonStruct(backups []attr.Value) ([]mssqlBackupToJsonConvert, error) {
+	var backupToConvert = []mssqlBackupToJsonConvert{}
+
+	for _, backup := range backups {
+		backupJson := mssqlBackupToJsonConvert{}
+		if err := json.Unmarshal([]byte(backup.String()), &backupJson); err != nil {
+			return nil, err
+		}
+		backupToConvert = append(backupToConvert, backupJson)
+	}
+
+	return backupToConvert, nil
+}
+
+func flattenMssqlBackups(list []*vmssql.BackupFile) []*mssqlBackup {
+	var outputs []*mssqlBackup
+
+	for _, v := range list {
+		var output mssqlBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *mssqlBackup) refreshFromOutput(output *vmssql.BackupFile) {
+	d.FileName = types.StringPointerValue(output.FileName)
+	d.DatabaseName = types.StringPointerValue(output.DatabaseName)
+	d.ServerName = types.StringPointerValue(output.CloudMssqlServerName)
+	d.BackupType = types.StringPointerValue(common.GetCodePtrByCommonCode(output.BackupType))
+	d.FirstLsn = types.StringPointerValue(output.FirstLsn)
+	d.LastLsn = types.StringPointerValue(output.LastLsn)
+	d.StartTime = types.StringPointerValue(output.StartTime)
+	d.EndTime = types.StringPointerValue(output.EndTime)
+}
+
+func (d *mssqlBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*mssqlBackup, instance string) diag.Diagnostics {
+	d.ID = types.StringValue(instance)
+	d.MssqlInstanceNo = types.StringValue(instance)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mssqlBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.MssqlBackupList = backupListValue
+
+	return diags
+}
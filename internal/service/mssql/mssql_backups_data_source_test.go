@@ -0,0 +1,66 @@
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	randacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudMssqlBackups_vpc_basic(t *testing.T) {
+	dataName := "data.ncloud_mssql_backups.all"
+	resourceName := "ncloud_mssql.mssql"
+	testMssqlName := fmt.Sprintf("tf-mssqlbk-%s", randacctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMssqlBackupsConfig(testMssqlName),
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttrPair(dataName, "mssql_instance_no", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMssqlBackupsConfig(testMssqlName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.0.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.0.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mssql" "mssql" {
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	is_ha = false
+	is_automatic_backup = true
+	user_name = "test"
+	user_password = "qwer1234!"
+}
+
+data "ncloud_mssql_backups" "all" {
+	mssql_instance_no = ncloud_mssql.mssql.id
+	filter {
+		name = "backup_type"
+		values = ["FULL"]
+	}
+}
+	`, testMssqlName)
+}
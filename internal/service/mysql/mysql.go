@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -287,6 +288,24 @@ func (m *mysqlResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"vpc_no": schema.StringAttribute{
 				Computed: true,
 			},
+			"writer_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reader_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_uri": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"access_control_group_no_list": schema.ListAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
@@ -741,6 +760,9 @@ type mysqlResourceModel struct {
 	EngineVersionCode         types.String `tfsdk:"engine_version_code"`
 	RegionCode                types.String `tfsdk:"region_code"`
 	VpcNo                     types.String `tfsdk:"vpc_no"`
+	WriterEndpoint            types.String `tfsdk:"writer_endpoint"`
+	ReaderEndpoint            types.String `tfsdk:"reader_endpoint"`
+	ConnectionUri             types.String `tfsdk:"connection_uri"`
 	AccessControlGroupNoList  types.List   `tfsdk:"access_control_group_no_list"`
 	MysqlConfigList           types.List   `tfsdk:"mysql_config_list"`
 	MysqlServerList           types.List   `tfsdk:"mysql_server_list"`
@@ -799,6 +821,7 @@ func (r *mysqlResourceModel) refreshFromOutput(ctx context.Context, output *vmys
 	r.Port = common.Int64ValueFromInt32(output.CloudMysqlPort)
 	r.RegionCode = types.StringPointerValue(output.CloudMysqlServerInstanceList[0].RegionCode)
 	r.VpcNo = types.StringPointerValue(output.CloudMysqlServerInstanceList[0].VpcNo)
+	r.refreshEndpoints(output)
 
 	acgList, diags := types.ListValueFrom(ctx, types.StringType, output.AccessControlGroupNoList)
 	if diags.HasError() {
@@ -815,6 +838,48 @@ func (r *mysqlResourceModel) refreshFromOutput(ctx context.Context, output *vmys
 	return diags
 }
 
+// refreshEndpoints derives the connection endpoints from the private domains of the master and the first slave server.
+func (r *mysqlResourceModel) refreshEndpoints(output *vmysql.CloudMysqlInstance) {
+	r.WriterEndpoint = types.StringNull()
+	r.ReaderEndpoint = types.StringNull()
+	r.ConnectionUri = types.StringNull()
+
+	if output.CloudMysqlPort == nil {
+		return
+	}
+
+	for _, server := range output.CloudMysqlServerInstanceList {
+		role := common.GetCodePtrByCommonCode(server.CloudMysqlServerRole)
+		if role == nil || server.PrivateDomain == nil {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("%s:%d", *server.PrivateDomain, *output.CloudMysqlPort)
+		switch *role {
+		case "M":
+			r.WriterEndpoint = types.StringValue(endpoint)
+		case "S":
+			if r.ReaderEndpoint.IsNull() {
+				r.ReaderEndpoint = types.StringValue(endpoint)
+			}
+		}
+	}
+
+	if r.WriterEndpoint.IsNull() {
+		return
+	}
+
+	uri := url.URL{
+		Scheme: "mysql",
+		Host:   r.WriterEndpoint.ValueString(),
+		Path:   "/" + r.DatabaseName.ValueString(),
+	}
+	if !r.UserName.IsNull() && !r.UserName.IsUnknown() {
+		uri.User = url.User(r.UserName.ValueString())
+	}
+	r.ConnectionUri = types.StringValue(uri.String())
+}
+
 func listValueFromMysqlServerList(ctx context.Context, serverInatances []*vmysql.CloudMysqlServerInstance) (basetypes.ListValue, diag.Diagnostics) {
 	var serverList []mysqlServer
 	for _, server := range serverInatances {
@@ -0,0 +1,282 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &mysqlBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &mysqlBackupsDataSource{}
+)
+
+func NewMysqlBackupsDataSource() datasource.DataSource {
+	return &mysqlBackupsDataSource{}
+}
+
+type mysqlBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *mysqlBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_backups"
+}
+
+func (d *mysqlBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *mysqlBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("mysql_instance_no"),
+					),
+				},
+			},
+			"mysql_instance_no": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("id"),
+					),
+				},
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"mysql_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"data_storage_size": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *mysqlBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data mysqlBackupsDataSourceModel
+	var mysqlId string
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		mysqlId = data.ID.ValueString()
+	}
+
+	if !data.MysqlInstanceNo.IsNull() && !data.MysqlInstanceNo.IsUnknown() {
+		mysqlId = data.MysqlInstanceNo.ValueString()
+	}
+
+	output, err := GetMysqlBackupDetailAllList(ctx, d.config, mysqlId)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	mysqlBackupList := flattenMysqlBackups(output)
+	fillteredList := common.FilterModels(ctx, data.Filters, mysqlBackupList)
+
+	if data.MostRecent.ValueBool() {
+		fillteredList = common.MostRecent(fillteredList, func(b *mysqlBackup) *string {
+			return b.EndTime.ValueStringPointer()
+		})
+	}
+
+	if diags := data.refreshFromOutput(ctx, fillteredList, mysqlId); diags.HasError() {
+		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		outputPath := data.OutputFile.ValueString()
+
+		if convertedList, err := convertBackupsToJsonStruct(data.MysqlBackupList.Elements()); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		} else if err := common.WriteToFile(outputPath, convertedList); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func GetMysqlBackupDetailAllList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vmysql.CloudMysqlBackupDetail, error) {
+	var allBackups []*vmysql.CloudMysqlBackupDetail
+	pageNo := int32(0)
+	pageSize := int32(100)
+	hasMore := true
+
+	for hasMore {
+		reqParams := &vmysql.GetCloudMysqlBackupDetailListRequest{
+			RegionCode:           &config.RegionCode,
+			CloudMysqlInstanceNo: ncloud.String(id),
+			PageNo:               ncloud.Int32(pageNo),
+			PageSize:             ncloud.Int32(pageSize),
+		}
+		tflog.Info(ctx, "GetMysqlBackupDetailList reqParams="+common.MarshalUncheckedString(reqParams))
+
+		resp, err := config.Client.Vmysql.V2Api.GetCloudMysqlBackupDetailList(reqParams)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp == nil {
+			break
+		}
+
+		allBackups = append(allBackups, resp.CloudMysqlBackupDetailList...)
+
+		hasMore = len(resp.CloudMysqlBackupDetailList) == int(pageSize)
+		pageNo++
+	}
+
+	tflog.Info(ctx, "GetMysqlBackupDetailList response="+common.MarshalUncheckedString(allBackups))
+
+	return allBackups, nil
+}
+
+type mysqlBackupsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	MysqlInstanceNo types.String `tfsdk:"mysql_instance_no"`
+	MostRecent      types.Bool   `tfsdk:"most_recent"`
+	MysqlBackupList types.List   `tfsdk:"mysql_backup_list"`
+	OutputFile      types.String `tfsdk:"output_file"`
+	Filters         types.Set    `tfsdk:"filter"`
+}
+
+type mysqlBackup struct {
+	FileName        types.String `tfsdk:"file_name"`
+	StartTime       types.String `tfsdk:"start_time"`
+	EndTime         types.String `tfsdk:"end_time"`
+	BackupSize      types.Int64  `tfsdk:"backup_size"`
+	DataStorageSize types.Int64  `tfsdk:"data_storage_size"`
+}
+
+type mysqlBackupToJsonConvert struct {
+	FileName        string `json:"file_name"`
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	BackupSize      int64  `json:"backup_size"`
+	DataStorageSize int64  `json:"data_storage_size"`
+}
+
+func (d mysqlBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"file_name":         types.StringType,
+		"start_time":        types.StringType,
+		"end_time":          types.StringType,
+		"backup_size":       types.Int64Type,
+		"data_storage_size": types.Int64Type,
+	}
+}
+
+func convertBackupsToJsonStruct(backups []attr.Value) ([]mysqlBackupToJsonConvert, error) {
+	var backupToConvert = []mysqlBackupToJsonConvert{}
+
+	for _, backup := range backups {
+		backupJson := mysqlBackupToJsonConvert{}
+		if err := json.Unmarshal([]byte(backup.String()), &backupJson); err != nil {
+			return nil, err
+		}
+		backupToConvert = append(backupToConvert, backupJson)
+	}
+
+	return backupToConvert, nil
+}
+
+func flattenMysqlBackups(list []*vmysql.CloudMysqlBackupDetail) []*mysqlBackup {
+	var outputs []*mysqlBackup
+
+	for _, v := range list {
+		var output mysqlBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *mysqlBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*mysqlBackup, instance string) diag.Diagnostics {
+	d.ID = types.StringValue(instance)
+	d.MysqlInstanceNo = types.StringValue(instance)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mysqlBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+	d.MysqlBackupList = backupListValue
+	return nil
+}
+
+func (d *mysqlBackup) refreshFromOutput(output *vmysql.CloudMysqlBackupDetail) {
+	d.FileName = types.StringPointerValue(output.FileName)
+	d.StartTime = types.StringPointerValue(output.StartTime)
+	d.EndTime = types.StringPointerValue(output.EndTime)
+	d.BackupSize = types.Int64PointerValue(output.BackupSize)
+	d.DataStorageSize = types.Int64PointerValue(output.DataStorageSize)
+}
@@ -0,0 +1,64 @@
+package mysql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudMysqlBackups_vpc_basic(t *testing.T) {
+	testName := fmt.Sprintf("tf-mysqlbk-%s", acctest.RandString(5))
+	dataName := "data.ncloud_mysql_backups.all"
+	resourceName := "ncloud_mysql.mysql"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMysqlBackupsConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					TestAccCheckDataSourceID(dataName),
+					resource.TestCheckResourceAttrPair(dataName, "mysql_instance_no", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMysqlBackupsConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mysql" "mysql" {
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name = "testusername"
+	user_password = "t123456789!a"
+	host_ip = "192.168.0.1"
+	database_name = "test_db"
+}
+
+data "ncloud_mysql_backups" "all" {
+	mysql_instance_no = ncloud_mysql.mysql.id
+	most_recent = true
+}
+`, testName)
+}
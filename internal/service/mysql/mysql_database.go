@@ -0,0 +1,242 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &mysqlDatabaseResource{}
+	_ resource.ResourceWithConfigure   = &mysqlDatabaseResource{}
+	_ resource.ResourceWithImportState = &mysqlDatabaseResource{}
+)
+
+func NewMysqlDatabaseResource() resource.Resource {
+	return &mysqlDatabaseResource{}
+}
+
+type mysqlDatabaseResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *mysqlDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: mysql_instance_no:name Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mysql_instance_no"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+func (r *mysqlDatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *mysqlDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_database"
+}
+
+func (r *mysqlDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"mysql_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 30),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z]+[a-zA-Z0-9-\\_,]+$`),
+						"Composed of alphabets, numbers, hyphen (-), (\\), (_), (,). Must start with an alphabetic character.",
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *mysqlDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mysqlDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to users and databases of an instance are rejected while another one is in progress
+	conn.GlobalMutexKV.Lock(plan.MysqlInstanceNo.ValueString())
+	defer conn.GlobalMutexKV.Unlock(plan.MysqlInstanceNo.ValueString())
+
+	_, err := waitMysqlCreation(ctx, r.config, plan.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MYSQL CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vmysql.AddCloudMysqlDatabaseListRequest{
+		RegionCode:                 &r.config.RegionCode,
+		CloudMysqlInstanceNo:       plan.MysqlInstanceNo.ValueStringPointer(),
+		CloudMysqlDatabaseNameList: []*string{plan.Name.ValueStringPointer()},
+	}
+	tflog.Info(ctx, "CreateMysqlDatabase reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := r.config.Client.Vmysql.V2Api.AddCloudMysqlDatabaseList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "CreateMysqlDatabase response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
+		return
+	}
+
+	_, err = waitMysqlCreation(ctx, r.config, plan.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MYSQL CREATION ERROR", err.Error())
+		return
+	}
+
+	output, err := GetMysqlDatabaseList(ctx, r.config, plan.MysqlInstanceNo.ValueString(), []string{plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", "no result. database is not found after creation")
+		return
+	}
+
+	plan.refreshFromOutput(output[0], plan.MysqlInstanceNo.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *mysqlDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mysqlDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetMysqlDatabaseList(ctx, r.config, state.MysqlInstanceNo.ValueString(), []string{state.Name.ValueString()})
+	if err != nil {
+		if CheckIfAlreadyDeleted(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output[0], state.MysqlInstanceNo.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *mysqlDatabaseResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *mysqlDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mysqlDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to users and databases of an instance are rejected while another one is in progress
+	conn.GlobalMutexKV.Lock(state.MysqlInstanceNo.ValueString())
+	defer conn.GlobalMutexKV.Unlock(state.MysqlInstanceNo.ValueString())
+
+	_, err := waitMysqlCreation(ctx, r.config, state.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MYSQL DELETE ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vmysql.DeleteCloudMysqlDatabaseListRequest{
+		RegionCode:                 &r.config.RegionCode,
+		CloudMysqlInstanceNo:       state.MysqlInstanceNo.ValueStringPointer(),
+		CloudMysqlDatabaseNameList: []*string{state.Name.ValueStringPointer()},
+	}
+	tflog.Info(ctx, "DeleteMysqlDatabase reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlDatabaseList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DeleteMysqlDatabase response="+common.MarshalUncheckedString(response))
+
+	_, err = waitMysqlCreation(ctx, r.config, state.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MYSQL DELETE ERROR", err.Error())
+		return
+	}
+}
+
+type mysqlDatabaseResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	MysqlInstanceNo types.String `tfsdk:"mysql_instance_no"`
+	Name            types.String `tfsdk:"name"`
+}
+
+func (r *mysqlDatabaseResourceModel) refreshFromOutput(output *vmysql.CloudMysqlDatabase, instanceNo string) {
+	r.ID = types.StringValue(fmt.Sprintf("%s:%s", instanceNo, *output.DatabaseName))
+	r.MysqlInstanceNo = types.StringValue(instanceNo)
+	r.Name = types.StringPointerValue(output.DatabaseName)
+}
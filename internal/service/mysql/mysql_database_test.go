@@ -0,0 +1,95 @@
+package mysql_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	mysqlservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/mysql"
+)
+
+func TestAccResourceNcloudMysqlDatabase_vpc_basic(t *testing.T) {
+	testName := fmt.Sprintf("tf-mysqldb-%s", acctest.RandString(5))
+	resourceName := "ncloud_mysql_database.mysql_db"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMysqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlDatabaseConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "testdb"),
+					resource.TestCheckResourceAttrPair(resourceName, "mysql_instance_no", "ncloud_mysql.mysql", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMysqlDatabaseConfig(testMysqlName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name             = "%[1]s"
+	ipv4_cidr_block  = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_mysql" "mysql" {
+	subnet_no = ncloud_subnet.test_subnet.id
+	service_name = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name = "testusername"
+	user_password = "t123456789!a"
+	host_ip = "192.168.0.1"
+	database_name = "test_db"
+}
+
+resource "ncloud_mysql_database" "mysql_db" {
+	mysql_instance_no = ncloud_mysql.mysql.id
+	name = "testdb"
+}
+`, testMysqlName)
+}
+
+func testAccCheckMysqlDatabaseDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_mysql_database" {
+			continue
+		}
+
+		dbs, err := mysqlservice.GetMysqlDatabaseList(context.Background(), config, rs.Primary.Attributes["mysql_instance_no"], []string{rs.Primary.Attributes["name"]})
+		if err != nil && !mysqlservice.CheckIfAlreadyDeleted(err) {
+			return err
+		}
+
+		if len(dbs) > 0 {
+			return errors.New("mysql database still exists")
+		}
+	}
+
+	return nil
+}
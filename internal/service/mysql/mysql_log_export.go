@@ -0,0 +1,449 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource               = &mysqlLogExportResource{}
+	_ resource.ResourceWithConfigure  = &mysqlLogExportResource{}
+	_ resource.ResourceWithModifyPlan = &mysqlLogExportResource{}
+)
+
+func NewMysqlLogExportResource() resource.Resource {
+	return &mysqlLogExportResource{}
+}
+
+type mysqlLogExportResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *mysqlLogExportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *mysqlLogExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_log_export"
+}
+
+func (r *mysqlLogExportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"mysql_server_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("MYSQL_ERROR_LOG", "MYSQL_SLOW_LOG", "MYSQL_GENERAL_LOG", "MYSQL_AUDIT_LOG"),
+				},
+			},
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder_path": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"exported_file_list": schema.ListNestedAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"file_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"file_date": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *mysqlLogExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mysqlLogExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new server may have no log file yet, they are exported by Update as they appear
+	logs, missing, err := listMysqlLogsToExport(ctx, r.config, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	warnMissingLogFiles(&resp.Diagnostics, missing)
+
+	if err := r.exportLogs(ctx, &plan, logs); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if diags := plan.refreshFromOutput(ctx, logs); diags.HasError() {
+		resp.Diagnostics.AddError("CREATING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Exported files are plain objects in the bucket, so Read only checks that the server still exists.
+// Log files generated since the last export are detected in plan and exported by Update.
+func (r *mysqlLogExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mysqlLogExportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := GetMysqlDbServerLogList(ctx, r.config, state.MysqlServerInstanceNo.ValueString(), state.LogType.ValueString())
+	if err != nil {
+		if CheckIfAlreadyDeleted(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update exports the log files that were added or written to since the last export.
+func (r *mysqlLogExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mysqlLogExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logs, missing, err := listMysqlLogsToExport(ctx, r.config, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+	warnMissingLogFiles(&resp.Diagnostics, missing)
+
+	if err := r.exportLogs(ctx, &plan, changedMysqlDbServerLogs(logs, state.ExportedFileList)); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	if diags := plan.refreshFromOutput(ctx, logs); diags.HasError() {
+		resp.Diagnostics.AddError("UPDATING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Deleting the resource leaves the exported files in the bucket.
+func (r *mysqlLogExportResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ModifyPlan plans an update when log files were added or rotated away since the last export,
+// so that the next apply delivers the new logs to the bucket. Logs still being written to are
+// exported again along with them, not on their own.
+func (r *mysqlLogExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state mysqlLogExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.MysqlServerInstanceNo.IsUnknown() || plan.LogType.IsUnknown() || plan.FileNames.IsUnknown() {
+		return
+	}
+
+	logs, _, err := listMysqlLogsToExport(ctx, r.config, &plan)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to list log files", err.Error())
+		return
+	}
+
+	if mysqlLogFileNamesChanged(logs, state.ExportedFileList) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("exported_file_list"), types.ListUnknown(types.ObjectType{AttrTypes: mysqlExportedLog{}.attrTypes()}))...)
+	}
+}
+
+func (r *mysqlLogExportResource) exportLogs(ctx context.Context, plan *mysqlLogExportResourceModel, logs []*vmysql.CloudMysqlDbServerLog) error {
+	for _, log := range logs {
+		reqParams := &vmysql.ExportDbServerLogToObjectStorageRequest{
+			RegionCode:                 &r.config.RegionCode,
+			LogType:                    plan.LogType.ValueStringPointer(),
+			FileName:                   log.FileName,
+			BucketName:                 plan.BucketName.ValueStringPointer(),
+			CloudMysqlServerInstanceNo: plan.MysqlServerInstanceNo.ValueStringPointer(),
+		}
+
+		if !plan.FolderPath.IsNull() {
+			reqParams.FolderPath = plan.FolderPath.ValueStringPointer()
+		}
+		tflog.Info(ctx, "ExportMysqlDbServerLog reqParams="+common.MarshalUncheckedString(reqParams))
+
+		response, err := r.config.Client.Vmysql.V2Api.ExportDbServerLogToObjectStorage(reqParams)
+		if err != nil {
+			return err
+		}
+		tflog.Info(ctx, "ExportMysqlDbServerLog response="+common.MarshalUncheckedString(response))
+
+		if response == nil || *response.ReturnCode != "0" {
+			return fmt.Errorf("response invalid")
+		}
+	}
+
+	return nil
+}
+
+// listMysqlLogsToExport returns the log files of log_type, limited to file_names when it is set,
+// along with the names in file_names that no longer exist on the server.
+func listMysqlLogsToExport(ctx context.Context, config *conn.ProviderConfig, plan *mysqlLogExportResourceModel) ([]*vmysql.CloudMysqlDbServerLog, []string, error) {
+	logs, err := GetMysqlDbServerLogList(ctx, config, plan.MysqlServerInstanceNo.ValueString(), plan.LogType.ValueString())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if plan.FileNames.IsNull() || plan.FileNames.IsUnknown() {
+		return logs, nil, nil
+	}
+
+	var fileNames []string
+	if diags := plan.FileNames.ElementsAs(ctx, &fileNames, false); diags.HasError() {
+		return nil, nil, fmt.Errorf("invalid file_names")
+	}
+
+	filtered, missing := filterMysqlDbServerLogs(logs, fileNames)
+	return filtered, missing, nil
+}
+
+func warnMissingLogFiles(diags *diag.Diagnostics, missing []string) {
+	for _, name := range missing {
+		diags.AddWarning("Log file not found", fmt.Sprintf("log file %s no longer exists on the server and is not exported", name))
+	}
+}
+
+func GetMysqlDbServerLogList(ctx context.Context, config *conn.ProviderConfig, serverInstanceNo string, logType string) ([]*vmysql.CloudMysqlDbServerLog, error) {
+	reqParams := &vmysql.GetDbServerLogListRequest{
+		RegionCode:                 &config.RegionCode,
+		LogType:                    ncloud.String(logType),
+		CloudMysqlServerInstanceNo: ncloud.String(serverInstanceNo),
+	}
+	tflog.Info(ctx, "GetMysqlDbServerLogList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vmysql.V2Api.GetDbServerLogList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	tflog.Info(ctx, "GetMysqlDbServerLogList response="+common.MarshalUncheckedString(resp))
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.CloudMysqlDbServerLogList, nil
+}
+
+// filterMysqlDbServerLogs keeps the log files listed in fileNames. Rotated files are returned as missing.
+func filterMysqlDbServerLogs(logs []*vmysql.CloudMysqlDbServerLog, fileNames []string) ([]*vmysql.CloudMysqlDbServerLog, []string) {
+	logMap := make(map[string]*vmysql.CloudMysqlDbServerLog)
+	for _, log := range logs {
+		if log != nil && log.FileName != nil {
+			logMap[*log.FileName] = log
+		}
+	}
+
+	var filtered []*vmysql.CloudMysqlDbServerLog
+	var missing []string
+	for _, name := range fileNames {
+		log, exists := logMap[name]
+		if !exists {
+			missing = append(missing, name)
+			continue
+		}
+		filtered = append(filtered, log)
+	}
+
+	return filtered, missing
+}
+
+// mysqlLogFileNamesChanged reports whether log files were added or removed since the export.
+func mysqlLogFileNamesChanged(logs []*vmysql.CloudMysqlDbServerLog, exported types.List) bool {
+	exportedNames := make(map[string]bool)
+	for _, v := range exported.Elements() {
+		exportedNames[v.(types.Object).Attributes()["file_name"].(types.String).ValueString()] = true
+	}
+
+	names := make(map[string]bool)
+	for _, log := range logs {
+		if log != nil && log.FileName != nil {
+			names[*log.FileName] = true
+		}
+	}
+
+	if len(names) != len(exportedNames) {
+		return true
+	}
+
+	for name := range names {
+		if !exportedNames[name] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// changedMysqlDbServerLogs returns the log files that are not exported yet, or whose size or date changed since the export.
+func changedMysqlDbServerLogs(logs []*vmysql.CloudMysqlDbServerLog, exported types.List) []*vmysql.CloudMysqlDbServerLog {
+	exportedLogs := make(map[string]mysqlExportedLog)
+	for _, v := range exported.Elements() {
+		attrs := v.(types.Object).Attributes()
+		exportedLogs[attrs["file_name"].(types.String).ValueString()] = mysqlExportedLog{
+			FileSize: attrs["file_size"].(types.Int64),
+			FileDate: attrs["file_date"].(types.String),
+		}
+	}
+
+	var changed []*vmysql.CloudMysqlDbServerLog
+	for _, log := range logs {
+		if log == nil || log.FileName == nil {
+			continue
+		}
+
+		previous, ok := exportedLogs[*log.FileName]
+		if !ok || !previous.FileSize.Equal(types.Int64PointerValue(log.FileSize)) || !previous.FileDate.Equal(types.StringPointerValue(log.FileDate)) {
+			changed = append(changed, log)
+		}
+	}
+
+	return changed
+}
+
+type mysqlLogExportResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	MysqlServerInstanceNo types.String `tfsdk:"mysql_server_instance_no"`
+	LogType               types.String `tfsdk:"log_type"`
+	BucketName            types.String `tfsdk:"bucket_name"`
+	FolderPath            types.String `tfsdk:"folder_path"`
+	FileNames             types.List   `tfsdk:"file_names"`
+	Triggers              types.Map    `tfsdk:"triggers"`
+	ExportedFileList      types.List   `tfsdk:"exported_file_list"`
+}
+
+type mysqlExportedLog struct {
+	FileName types.String `tfsdk:"file_name"`
+	FileSize types.Int64  `tfsdk:"file_size"`
+	FileDate types.String `tfsdk:"file_date"`
+}
+
+func (r mysqlExportedLog) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"file_name": types.StringType,
+		"file_size": types.Int64Type,
+		"file_date": types.StringType,
+	}
+}
+
+func (r *mysqlLogExportResourceModel) refreshFromOutput(ctx context.Context, output []*vmysql.CloudMysqlDbServerLog) diag.Diagnostics {
+	r.ID = types.StringValue(fmt.Sprintf("%s:%s", r.MysqlServerInstanceNo.ValueString(), r.LogType.ValueString()))
+
+	logList := []mysqlExportedLog{}
+	for _, log := range output {
+		logList = append(logList, mysqlExportedLog{
+			FileName: types.StringPointerValue(log.FileName),
+			FileSize: types.Int64PointerValue(log.FileSize),
+			FileDate: types.StringPointerValue(log.FileDate),
+		})
+	}
+
+	exportedFiles, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: mysqlExportedLog{}.attrTypes()}, logList)
+	if diags.HasError() {
+		return diags
+	}
+
+	r.ExportedFileList = exportedFiles
+
+	return diags
+}
@@ -43,6 +43,8 @@ func TestAccResourceNcloudMysql_vpc_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "is_storage_encryption", "false"),
 					resource.TestCheckResourceAttr(resourceName, "is_backup", "true"),
 					resource.TestCheckResourceAttr(resourceName, "backup_file_retention_period", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "writer_endpoint"),
+					resource.TestMatchResourceAttr(resourceName, "connection_uri", regexp.MustCompile(`^mysql://testusername@.+/test_db$`)),
 				),
 			},
 		},
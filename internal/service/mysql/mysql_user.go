@@ -0,0 +1,354 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vmysql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &mysqlUserResource{}
+	_ resource.ResourceWithConfigure   = &mysqlUserResource{}
+	_ resource.ResourceWithImportState = &mysqlUserResource{}
+)
+
+func NewMysqlUserResource() resource.Resource {
+	return &mysqlUserResource{}
+}
+
+type mysqlUserResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *mysqlUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: mysql_instance_no:name Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mysql_instance_no"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+func (r *mysqlUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *mysqlUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_user"
+}
+
+func (r *mysqlUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"mysql_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(4, 16),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z]+[a-zA-Z0-9-\\_,]+$`),
+						"Composed of alphabets, numbers, hyphen (-), (\\), (_), (,). Must start with an alphabetic character.",
+					),
+				},
+			},
+			"host_ip": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.All(
+						stringvalidator.LengthBetween(8, 20),
+						stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+						stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+					),
+				},
+			},
+			"authority": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"READ", "CRUD", "DDL"}...),
+				},
+			},
+			"is_system_table_access": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *mysqlUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan mysqlUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to users and databases of an instance are rejected while another one is in progress
+	conn.GlobalMutexKV.Lock(plan.MysqlInstanceNo.ValueString())
+	defer conn.GlobalMutexKV.Unlock(plan.MysqlInstanceNo.ValueString())
+
+	_, err := waitMysqlCreation(ctx, r.config, plan.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MYSQL CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vmysql.AddCloudMysqlUserListRequest{
+		RegionCode:           &r.config.RegionCode,
+		CloudMysqlInstanceNo: plan.MysqlInstanceNo.ValueStringPointer(),
+		CloudMysqlUserList:   []*vmysql.CloudMysqlUserParameter{plan.toUserParameter()},
+	}
+
+	response, err := r.config.Client.Vmysql.V2Api.AddCloudMysqlUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "CreateMysqlUser response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
+		return
+	}
+
+	_, err = waitMysqlCreation(ctx, r.config, plan.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MYSQL CREATION ERROR", err.Error())
+		return
+	}
+
+	output, err := GetMysqlUserList(ctx, r.config, plan.MysqlInstanceNo.ValueString(), []string{plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", "no result. user is not found after creation")
+		return
+	}
+
+	plan.refreshFromOutput(output[0], plan.MysqlInstanceNo.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *mysqlUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mysqlUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetMysqlUserList(ctx, r.config, state.MysqlInstanceNo.ValueString(), []string{state.Name.ValueString()})
+	if err != nil {
+		if CheckIfAlreadyDeleted(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output[0], state.MysqlInstanceNo.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *mysqlUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state mysqlUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to users and databases of an instance are rejected while another one is in progress
+	conn.GlobalMutexKV.Lock(state.MysqlInstanceNo.ValueString())
+	defer conn.GlobalMutexKV.Unlock(state.MysqlInstanceNo.ValueString())
+
+	if !plan.Password.Equal(state.Password) ||
+		!plan.Authority.Equal(state.Authority) ||
+		!plan.IsSystemTableAccess.Equal(state.IsSystemTableAccess) {
+		reqParams := &vmysql.ChangeCloudMysqlUserListRequest{
+			RegionCode:           &r.config.RegionCode,
+			CloudMysqlInstanceNo: state.MysqlInstanceNo.ValueStringPointer(),
+			CloudMysqlUserList:   []*vmysql.CloudMysqlUserParameter{plan.toUserParameter()},
+		}
+
+		response, err := r.config.Client.Vmysql.V2Api.ChangeCloudMysqlUserList(reqParams)
+		if err != nil {
+			resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+			return
+		}
+		tflog.Info(ctx, "ChangeMysqlUser response="+common.MarshalUncheckedString(response))
+
+		if response == nil || *response.ReturnCode != "0" {
+			resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
+			return
+		}
+
+		_, err = waitMysqlCreation(ctx, r.config, state.MysqlInstanceNo.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("WAITING FOR UPDATE ERROR", err.Error())
+			return
+		}
+
+		output, err := GetMysqlUserList(ctx, r.config, state.MysqlInstanceNo.ValueString(), []string{state.Name.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("READING ERROR", err.Error())
+			return
+		}
+
+		if output == nil {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		plan.refreshFromOutput(output[0], state.MysqlInstanceNo.ValueString())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *mysqlUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state mysqlUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to users and databases of an instance are rejected while another one is in progress
+	conn.GlobalMutexKV.Lock(state.MysqlInstanceNo.ValueString())
+	defer conn.GlobalMutexKV.Unlock(state.MysqlInstanceNo.ValueString())
+
+	_, err := waitMysqlCreation(ctx, r.config, state.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR MYSQL CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vmysql.DeleteCloudMysqlUserListRequest{
+		RegionCode:           &r.config.RegionCode,
+		CloudMysqlInstanceNo: state.MysqlInstanceNo.ValueStringPointer(),
+		CloudMysqlUserList: []*vmysql.CloudMysqlUserKeyParameter{
+			{
+				Name:   state.Name.ValueStringPointer(),
+				HostIp: state.HostIp.ValueStringPointer(),
+			},
+		},
+	}
+	tflog.Info(ctx, "DeleteMysqlUser reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := r.config.Client.Vmysql.V2Api.DeleteCloudMysqlUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DeleteMysqlUser response="+common.MarshalUncheckedString(response))
+
+	_, err = waitMysqlCreation(ctx, r.config, state.MysqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
+		return
+	}
+}
+
+type mysqlUserResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	MysqlInstanceNo     types.String `tfsdk:"mysql_instance_no"`
+	Name                types.String `tfsdk:"name"`
+	HostIp              types.String `tfsdk:"host_ip"`
+	Password            types.String `tfsdk:"password"`
+	Authority           types.String `tfsdk:"authority"`
+	IsSystemTableAccess types.Bool   `tfsdk:"is_system_table_access"`
+}
+
+func (r *mysqlUserResourceModel) toUserParameter() *vmysql.CloudMysqlUserParameter {
+	param := &vmysql.CloudMysqlUserParameter{
+		Name:      r.Name.ValueStringPointer(),
+		HostIp:    r.HostIp.ValueStringPointer(),
+		Password:  r.Password.ValueStringPointer(),
+		Authority: r.Authority.ValueStringPointer(),
+	}
+
+	if !r.IsSystemTableAccess.IsNull() && !r.IsSystemTableAccess.IsUnknown() {
+		param.IsSystemTableAccess = r.IsSystemTableAccess.ValueBoolPointer()
+	}
+
+	return param
+}
+
+func (r *mysqlUserResourceModel) refreshFromOutput(output *vmysql.CloudMysqlUser, instanceNo string) {
+	r.ID = types.StringValue(fmt.Sprintf("%s:%s", instanceNo, *output.UserName))
+	r.MysqlInstanceNo = types.StringValue(instanceNo)
+	r.Name = types.StringPointerValue(output.UserName)
+	r.HostIp = types.StringPointerValue(output.HostIp)
+	r.Authority = types.StringPointerValue(output.Authority)
+	r.IsSystemTableAccess = types.BoolPointerValue(output.IsSystemTableAccess)
+}
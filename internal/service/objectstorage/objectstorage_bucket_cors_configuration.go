@@ -0,0 +1,438 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketCORSConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &bucketCORSConfigurationResource{}
+	_ resource.ResourceWithImportState = &bucketCORSConfigurationResource{}
+)
+
+func NewBucketCORSConfigurationResource() resource.Resource {
+	return &bucketCORSConfigurationResource{}
+}
+
+type bucketCORSConfigurationResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketCORSConfigurationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"cors_rule": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"allowed_methods": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(
+									stringvalidator.OneOf("GET", "PUT", "HEAD", "POST", "DELETE"),
+								),
+							},
+						},
+						"allowed_origins": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"allowed_headers": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"expose_headers": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"max_age_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *bucketCORSConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketCORS(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketCORSApplied(ctx, b.config, plan.BucketName.ValueString()); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketCORSConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.refreshFromOutput(ctx, b.config, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.CORSRule.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketCORSConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.CORSRule.Equal(state.CORSRule) {
+		if err := putBucketCORS(ctx, b.config, &plan); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+
+		if err := waitBucketCORSApplied(ctx, b.config, plan.BucketName.ValueString()); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketCORSConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketCORSConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketCorsInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketCors reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketCors(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketCors response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketCORSConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_cors_configuration"
+}
+
+func (b *bucketCORSConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketCORSConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketCORS(ctx context.Context, config *conn.ProviderConfig, plan *bucketCORSConfigurationResourceModel) error {
+	var rules []corsRule
+	if diags := plan.CORSRule.ElementsAs(ctx, &rules, false); diags.HasError() {
+		return fmt.Errorf("invalid cors rule")
+	}
+
+	var corsRules []awsTypes.CORSRule
+	for _, rule := range rules {
+		corsRule, err := rule.toCORSRule(ctx)
+		if err != nil {
+			return err
+		}
+		corsRules = append(corsRules, corsRule)
+	}
+
+	reqParams := &s3.PutBucketCorsInput{
+		Bucket: plan.BucketName.ValueStringPointer(),
+		CORSConfiguration: &awsTypes.CORSConfiguration{
+			CORSRules: corsRules,
+		},
+	}
+
+	tflog.Info(ctx, "PutBucketCors reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketCors(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketCors response="+common.MarshalUncheckedString(response))
+
+	return nil
+}
+
+func waitBucketCORSApplied(ctx context.Context, config *conn.ProviderConfig, bucketName string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{APPLYING},
+		Target:  []string{APPLIED},
+		Refresh: func() (interface{}, string, error) {
+			output, err := config.Client.ObjectStorage.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+				Bucket: ncloud.String(bucketName),
+			})
+
+			if output != nil {
+				return output, APPLIED, nil
+			}
+
+			if err != nil {
+				return output, APPLYING, nil
+			}
+
+			return output, APPLYING, nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bucket cors configuration (%s) to be applied: %s", bucketName, err)
+	}
+	return nil
+}
+
+func isCORSConfigurationNotFound(err error) bool {
+	return strings.Contains(err.Error(), "NoSuchCORSConfiguration")
+}
+
+type bucketCORSConfigurationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	BucketName types.String `tfsdk:"bucket_name"`
+	CORSRule   types.List   `tfsdk:"cors_rule"`
+}
+
+type corsRule struct {
+	ID             types.String `tfsdk:"id"`
+	AllowedMethods types.List   `tfsdk:"allowed_methods"`
+	AllowedOrigins types.List   `tfsdk:"allowed_origins"`
+	AllowedHeaders types.List   `tfsdk:"allowed_headers"`
+	ExposeHeaders  types.List   `tfsdk:"expose_headers"`
+	MaxAgeSeconds  types.Int64  `tfsdk:"max_age_seconds"`
+}
+
+func (c corsRule) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":              types.StringType,
+		"allowed_methods": types.ListType{ElemType: types.StringType},
+		"allowed_origins": types.ListType{ElemType: types.StringType},
+		"allowed_headers": types.ListType{ElemType: types.StringType},
+		"expose_headers":  types.ListType{ElemType: types.StringType},
+		"max_age_seconds": types.Int64Type,
+	}
+}
+
+func (c *corsRule) toCORSRule(ctx context.Context) (awsTypes.CORSRule, error) {
+	rule := awsTypes.CORSRule{}
+
+	if !c.ID.IsNull() {
+		rule.ID = c.ID.ValueStringPointer()
+	}
+
+	for _, l := range []struct {
+		value  types.List
+		target *[]string
+	}{
+		{c.AllowedMethods, &rule.AllowedMethods},
+		{c.AllowedOrigins, &rule.AllowedOrigins},
+		{c.AllowedHeaders, &rule.AllowedHeaders},
+		{c.ExposeHeaders, &rule.ExposeHeaders},
+	} {
+		if l.value.IsNull() || l.value.IsUnknown() {
+			continue
+		}
+		if diags := l.value.ElementsAs(ctx, l.target, false); diags.HasError() {
+			return rule, fmt.Errorf("invalid cors rule")
+		}
+	}
+
+	if !c.MaxAgeSeconds.IsNull() {
+		rule.MaxAgeSeconds = ncloud.Int32(int32(c.MaxAgeSeconds.ValueInt64()))
+	}
+
+	return rule, nil
+}
+
+func flattenCORSRule(ctx context.Context, rule awsTypes.CORSRule) (corsRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	c := corsRule{
+		ID:            types.StringPointerValue(rule.ID),
+		MaxAgeSeconds: common.Int64ValueFromInt32(rule.MaxAgeSeconds),
+	}
+
+	c.AllowedMethods, diags = stringListValueOrNull(ctx, rule.AllowedMethods)
+	if diags.HasError() {
+		return c, diags
+	}
+
+	c.AllowedOrigins, diags = stringListValueOrNull(ctx, rule.AllowedOrigins)
+	if diags.HasError() {
+		return c, diags
+	}
+
+	c.AllowedHeaders, diags = stringListValueOrNull(ctx, rule.AllowedHeaders)
+	if diags.HasError() {
+		return c, diags
+	}
+
+	c.ExposeHeaders, diags = stringListValueOrNull(ctx, rule.ExposeHeaders)
+
+	return c, diags
+}
+
+func stringListValueOrNull(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType), nil
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+func (b *bucketCORSConfigurationResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, bucketName string, diag *diag.Diagnostics) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+
+	output, err := config.Client.ObjectStorage.GetBucketCors(ctx, &s3.GetBucketCorsInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if err != nil {
+		if isCORSConfigurationNotFound(err) || isNoSuchBucket(err) {
+			b.CORSRule = types.ListNull(types.ObjectType{AttrTypes: corsRule{}.attrTypes()})
+			return
+		}
+		diag.AddError("GetBucketCors ERROR", err.Error())
+		return
+	}
+	if output == nil {
+		diag.AddError("GetBucketCors ERROR", "output is nil")
+		return
+	}
+
+	var rules []corsRule
+	for _, rule := range output.CORSRules {
+		c, diags := flattenCORSRule(ctx, rule)
+		if diags.HasError() {
+			diag.Append(diags...)
+			return
+		}
+		rules = append(rules, c)
+	}
+
+	ruleList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: corsRule{}.attrTypes()}, rules)
+	if diags.HasError() {
+		diag.Append(diags...)
+		return
+	}
+
+	b.CORSRule = ruleList
+}
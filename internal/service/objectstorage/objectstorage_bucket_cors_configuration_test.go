@@ -0,0 +1,99 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_cors_configuration_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_cors_configuration.testing_cors"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBucketCORSConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketCORSConfigurationConfig(bucketName, "https://www.example.com", 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_origins.0", "https://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_headers.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.expose_headers.0", "ETag"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			{
+				Config: testAccBucketCORSConfigurationConfig(bucketName, "https://app.example.com", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_origins.0", "https://app.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketCORSConfigurationDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_objectstorage_bucket_cors_configuration" {
+			continue
+		}
+
+		_, err := config.Client.ObjectStorage.GetBucketCors(context.Background(), &s3.GetBucketCorsInput{
+			Bucket: ncloud.String(rs.Primary.Attributes["bucket_name"]),
+		})
+		if err == nil {
+			return fmt.Errorf("bucket cors configuration still exists")
+		}
+
+		if !strings.Contains(err.Error(), "NoSuchCORSConfiguration") && !strings.Contains(err.Error(), "NoSuchBucket") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketCORSConfigurationConfig(bucketName, origin string, maxAgeSeconds int) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_cors_configuration" "testing_cors" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+
+			cors_rule = [
+				{
+					id				= "frontend"
+					allowed_methods	= ["GET", "HEAD"]
+					allowed_origins	= ["%[2]s"]
+					allowed_headers	= ["*"]
+					expose_headers	= ["ETag"]
+					max_age_seconds	= %[3]d
+				}
+			]
+		}
+	`, bucketName, origin, maxAgeSeconds)
+}
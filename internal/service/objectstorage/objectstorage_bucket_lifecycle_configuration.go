@@ -0,0 +1,503 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &bucketLifecycleConfigurationResource{}
+	_ resource.ResourceWithImportState = &bucketLifecycleConfigurationResource{}
+)
+
+func NewBucketLifecycleConfigurationResource() resource.Resource {
+	return &bucketLifecycleConfigurationResource{}
+}
+
+type bucketLifecycleConfigurationResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketLifecycleConfigurationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"rule": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"status": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(awsTypes.ExpirationStatusEnabled),
+									string(awsTypes.ExpirationStatusDisabled),
+								),
+							},
+						},
+						"prefix": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+							Description: "Object key prefix to which the rule applies",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Object tags to which the rule applies",
+						},
+						"expiration_days": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("expiration_date")),
+							},
+						},
+						"expiration_date": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T00:00:00Z$`),
+									"Must be a date at midnight UTC in RFC3339 format. ex) 2024-01-01T00:00:00Z",
+								),
+							},
+						},
+						"noncurrent_version_expiration_days": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"abort_incomplete_multipart_upload_days": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *bucketLifecycleConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketLifecycleConfiguration(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketLifecycleConfigurationApplied(ctx, b.config, plan.BucketName.ValueString()); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketLifecycleConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.refreshFromOutput(ctx, b.config, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Rule.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketLifecycleConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Rule.Equal(state.Rule) {
+		if err := putBucketLifecycleConfiguration(ctx, b.config, &plan); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+
+		if err := waitBucketLifecycleConfigurationApplied(ctx, b.config, plan.BucketName.ValueString()); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketLifecycleConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketLifecycleConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketLifecycleInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketLifecycle reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketLifecycle(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketLifecycle response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketLifecycleConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_lifecycle_configuration"
+}
+
+func (b *bucketLifecycleConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketLifecycleConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketLifecycleConfiguration(ctx context.Context, config *conn.ProviderConfig, plan *bucketLifecycleConfigurationResourceModel) error {
+	var rules []lifecycleRule
+	if diags := plan.Rule.ElementsAs(ctx, &rules, false); diags.HasError() {
+		return fmt.Errorf("invalid lifecycle rule")
+	}
+
+	var lifecycleRules []awsTypes.LifecycleRule
+	for _, rule := range rules {
+		lifecycleRule, err := rule.toLifecycleRule(ctx)
+		if err != nil {
+			return err
+		}
+		lifecycleRules = append(lifecycleRules, lifecycleRule)
+	}
+
+	reqParams := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: plan.BucketName.ValueStringPointer(),
+		LifecycleConfiguration: &awsTypes.BucketLifecycleConfiguration{
+			Rules: lifecycleRules,
+		},
+	}
+
+	tflog.Info(ctx, "PutBucketLifecycleConfiguration reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketLifecycleConfiguration(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketLifecycleConfiguration response="+common.MarshalUncheckedString(response))
+
+	return nil
+}
+
+func waitBucketLifecycleConfigurationApplied(ctx context.Context, config *conn.ProviderConfig, bucketName string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{APPLYING},
+		Target:  []string{APPLIED},
+		Refresh: func() (interface{}, string, error) {
+			output, err := config.Client.ObjectStorage.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+				Bucket: ncloud.String(bucketName),
+			})
+
+			if output != nil {
+				return output, APPLIED, nil
+			}
+
+			if err != nil {
+				return output, APPLYING, nil
+			}
+
+			return output, APPLYING, nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bucket lifecycle configuration (%s) to be applied: %s", bucketName, err)
+	}
+	return nil
+}
+
+func isLifecycleConfigurationNotFound(err error) bool {
+	return strings.Contains(err.Error(), "NoSuchLifecycleConfiguration")
+}
+
+type bucketLifecycleConfigurationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	BucketName types.String `tfsdk:"bucket_name"`
+	Rule       types.List   `tfsdk:"rule"`
+}
+
+type lifecycleRule struct {
+	ID                                 types.String `tfsdk:"id"`
+	Status                             types.String `tfsdk:"status"`
+	Prefix                             types.String `tfsdk:"prefix"`
+	Tags                               types.Map    `tfsdk:"tags"`
+	ExpirationDays                     types.Int64  `tfsdk:"expiration_days"`
+	ExpirationDate                     types.String `tfsdk:"expiration_date"`
+	NoncurrentVersionExpirationDays    types.Int64  `tfsdk:"noncurrent_version_expiration_days"`
+	AbortIncompleteMultipartUploadDays types.Int64  `tfsdk:"abort_incomplete_multipart_upload_days"`
+}
+
+func (l lifecycleRule) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                                     types.StringType,
+		"status":                                 types.StringType,
+		"prefix":                                 types.StringType,
+		"tags":                                   types.MapType{ElemType: types.StringType},
+		"expiration_days":                        types.Int64Type,
+		"expiration_date":                        types.StringType,
+		"noncurrent_version_expiration_days":     types.Int64Type,
+		"abort_incomplete_multipart_upload_days": types.Int64Type,
+	}
+}
+
+func (l *lifecycleRule) toLifecycleRule(ctx context.Context) (awsTypes.LifecycleRule, error) {
+	rule := awsTypes.LifecycleRule{
+		ID:     l.ID.ValueStringPointer(),
+		Status: awsTypes.ExpirationStatus(l.Status.ValueString()),
+	}
+
+	tags := make(map[string]string)
+	if !l.Tags.IsNull() && !l.Tags.IsUnknown() {
+		if diags := l.Tags.ElementsAs(ctx, &tags, false); diags.HasError() {
+			return rule, fmt.Errorf("invalid tags of lifecycle rule %s", l.ID.ValueString())
+		}
+	}
+
+	switch {
+	case len(tags) == 0:
+		rule.Filter = &awsTypes.LifecycleRuleFilterMemberPrefix{Value: l.Prefix.ValueString()}
+	case len(tags) == 1 && l.Prefix.IsNull():
+		for key, value := range tags {
+			rule.Filter = &awsTypes.LifecycleRuleFilterMemberTag{Value: awsTypes.Tag{Key: ncloud.String(key), Value: ncloud.String(value)}}
+		}
+	default:
+		and := awsTypes.LifecycleRuleAndOperator{Prefix: l.Prefix.ValueStringPointer()}
+		for key, value := range tags {
+			and.Tags = append(and.Tags, awsTypes.Tag{Key: ncloud.String(key), Value: ncloud.String(value)})
+		}
+		rule.Filter = &awsTypes.LifecycleRuleFilterMemberAnd{Value: and}
+	}
+
+	if !l.ExpirationDays.IsNull() {
+		rule.Expiration = &awsTypes.LifecycleExpiration{Days: ncloud.Int32(int32(l.ExpirationDays.ValueInt64()))}
+	}
+
+	if !l.ExpirationDate.IsNull() {
+		date, err := time.Parse(time.RFC3339, l.ExpirationDate.ValueString())
+		if err != nil {
+			return rule, err
+		}
+		rule.Expiration = &awsTypes.LifecycleExpiration{Date: &date}
+	}
+
+	if !l.NoncurrentVersionExpirationDays.IsNull() {
+		rule.NoncurrentVersionExpiration = &awsTypes.NoncurrentVersionExpiration{NoncurrentDays: ncloud.Int32(int32(l.NoncurrentVersionExpirationDays.ValueInt64()))}
+	}
+
+	if !l.AbortIncompleteMultipartUploadDays.IsNull() {
+		rule.AbortIncompleteMultipartUpload = &awsTypes.AbortIncompleteMultipartUpload{DaysAfterInitiation: ncloud.Int32(int32(l.AbortIncompleteMultipartUploadDays.ValueInt64()))}
+	}
+
+	return rule, nil
+}
+
+func flattenLifecycleRule(ctx context.Context, rule awsTypes.LifecycleRule) (lifecycleRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	l := lifecycleRule{
+		ID:                                 types.StringPointerValue(rule.ID),
+		Status:                             types.StringValue(string(rule.Status)),
+		Prefix:                             types.StringNull(),
+		Tags:                               types.MapNull(types.StringType),
+		ExpirationDays:                     types.Int64Null(),
+		ExpirationDate:                     types.StringNull(),
+		NoncurrentVersionExpirationDays:    types.Int64Null(),
+		AbortIncompleteMultipartUploadDays: types.Int64Null(),
+	}
+
+	prefix := ""
+	tags := make(map[string]string)
+
+	if rule.Prefix != nil {
+		prefix = *rule.Prefix
+	}
+
+	switch filter := rule.Filter.(type) {
+	case *awsTypes.LifecycleRuleFilterMemberPrefix:
+		prefix = filter.Value
+	case *awsTypes.LifecycleRuleFilterMemberTag:
+		tags[*filter.Value.Key] = *filter.Value.Value
+	case *awsTypes.LifecycleRuleFilterMemberAnd:
+		prefix = ncloud.StringValue(filter.Value.Prefix)
+		for _, tag := range filter.Value.Tags {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	if prefix != "" {
+		l.Prefix = types.StringValue(prefix)
+	}
+
+	if len(tags) > 0 {
+		l.Tags, diags = types.MapValueFrom(ctx, types.StringType, tags)
+		if diags.HasError() {
+			return l, diags
+		}
+	}
+
+	if rule.Expiration != nil {
+		if rule.Expiration.Days != nil {
+			l.ExpirationDays = common.Int64ValueFromInt32(rule.Expiration.Days)
+		}
+		if rule.Expiration.Date != nil {
+			l.ExpirationDate = types.StringValue(rule.Expiration.Date.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if rule.NoncurrentVersionExpiration != nil && rule.NoncurrentVersionExpiration.NoncurrentDays != nil {
+		l.NoncurrentVersionExpirationDays = common.Int64ValueFromInt32(rule.NoncurrentVersionExpiration.NoncurrentDays)
+	}
+
+	if rule.AbortIncompleteMultipartUpload != nil && rule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+		l.AbortIncompleteMultipartUploadDays = common.Int64ValueFromInt32(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+	}
+
+	return l, diags
+}
+
+func (b *bucketLifecycleConfigurationResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, bucketName string, diag *diag.Diagnostics) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+
+	output, err := config.Client.ObjectStorage.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if err != nil {
+		if isLifecycleConfigurationNotFound(err) || isNoSuchBucket(err) {
+			b.Rule = types.ListNull(types.ObjectType{AttrTypes: lifecycleRule{}.attrTypes()})
+			return
+		}
+		diag.AddError("GetBucketLifecycleConfiguration ERROR", err.Error())
+		return
+	}
+	if output == nil {
+		diag.AddError("GetBucketLifecycleConfiguration ERROR", "output is nil")
+		return
+	}
+
+	var rules []lifecycleRule
+	for _, rule := range output.Rules {
+		l, diags := flattenLifecycleRule(ctx, rule)
+		if diags.HasError() {
+			diag.Append(diags...)
+			return
+		}
+		rules = append(rules, l)
+	}
+
+	ruleList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: lifecycleRule{}.attrTypes()}, rules)
+	if diags.HasError() {
+		diag.Append(diags...)
+		return
+	}
+
+	b.Rule = ruleList
+}
@@ -0,0 +1,106 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_lifecycle_configuration_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_lifecycle_configuration.testing_lifecycle"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig(bucketName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "expire-logs"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.abort_incomplete_multipart_upload_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.tags.class", "temp"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.noncurrent_version_expiration_days", "7"),
+				),
+			},
+			{
+				Config: testAccBucketLifecycleConfigurationConfig(bucketName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration_days", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBucketLifecycleConfigurationDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_objectstorage_bucket_lifecycle_configuration" {
+			continue
+		}
+
+		_, err := config.Client.ObjectStorage.GetBucketLifecycleConfiguration(context.Background(), &s3.GetBucketLifecycleConfigurationInput{
+			Bucket: ncloud.String(rs.Primary.Attributes["bucket_name"]),
+		})
+		if err == nil {
+			return fmt.Errorf("bucket lifecycle configuration still exists")
+		}
+
+		if !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration") && !strings.Contains(err.Error(), "NoSuchBucket") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketLifecycleConfigurationConfig(bucketName string, expirationDays int) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_lifecycle_configuration" "testing_lifecycle" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+
+			rule = [
+				{
+					id				= "expire-logs"
+					status			= "Enabled"
+					prefix			= "logs/"
+					expiration_days	= %[2]d
+					abort_incomplete_multipart_upload_days = 1
+				},
+				{
+					id				= "cleanup-temp"
+					status			= "Enabled"
+					tags			= {
+						class = "temp"
+					}
+					noncurrent_version_expiration_days = 7
+				}
+			]
+		}
+	`, bucketName, expirationDays)
+}
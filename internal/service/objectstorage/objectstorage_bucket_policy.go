@@ -0,0 +1,271 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifystring"
+)
+
+var (
+	_ resource.Resource                = &bucketPolicyResource{}
+	_ resource.ResourceWithConfigure   = &bucketPolicyResource{}
+	_ resource.ResourceWithImportState = &bucketPolicyResource{}
+)
+
+func NewBucketPolicyResource() resource.Resource {
+	return &bucketPolicyResource{}
+}
+
+type bucketPolicyResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketPolicyResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"policy": schema.StringAttribute{
+				Required:   true,
+				CustomType: PolicyType{},
+				Validators: []validator.String{
+					verifystring.ValidJSON(),
+				},
+				Description: "Bucket policy JSON document",
+			},
+		},
+	}
+}
+
+func (b *bucketPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketPolicy(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketPolicyApplied(ctx, b.config, plan.BucketName.ValueString(), plan.Policy.ValueString()); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.refreshFromOutput(ctx, b.config, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Policy.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !PolicyEquivalent(plan.Policy.ValueString(), state.Policy.ValueString()) {
+		if err := putBucketPolicy(ctx, b.config, &plan); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+
+		if err := waitBucketPolicyApplied(ctx, b.config, plan.BucketName.ValueString(), plan.Policy.ValueString()); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketPolicyInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketPolicy reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketPolicy(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketPolicy response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_policy"
+}
+
+func (b *bucketPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketPolicy(ctx context.Context, config *conn.ProviderConfig, plan *bucketPolicyResourceModel) error {
+	reqParams := &s3.PutBucketPolicyInput{
+		Bucket: plan.BucketName.ValueStringPointer(),
+		Policy: plan.Policy.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "PutBucketPolicy reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketPolicy(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketPolicy response="+common.MarshalUncheckedString(response))
+
+	return nil
+}
+
+// waitBucketPolicyApplied waits until the bucket returns the given policy, as the previous one is still returned for a while after an update.
+func waitBucketPolicyApplied(ctx context.Context, config *conn.ProviderConfig, bucketName, policy string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{APPLYING},
+		Target:  []string{APPLIED},
+		Refresh: func() (interface{}, string, error) {
+			output, err := config.Client.ObjectStorage.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+				Bucket: ncloud.String(bucketName),
+			})
+
+			if output != nil && output.Policy != nil && PolicyEquivalent(*output.Policy, policy) {
+				return output, APPLIED, nil
+			}
+
+			if err != nil {
+				return output, APPLYING, nil
+			}
+
+			return output, APPLYING, nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bucket policy (%s) to be applied: %s", bucketName, err)
+	}
+	return nil
+}
+
+func isBucketPolicyNotFound(err error) bool {
+	return strings.Contains(err.Error(), "NoSuchBucketPolicy")
+}
+
+type bucketPolicyResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	BucketName types.String `tfsdk:"bucket_name"`
+	Policy     PolicyValue  `tfsdk:"policy"`
+}
+
+func (b *bucketPolicyResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, bucketName string, diag *diag.Diagnostics) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+
+	output, err := config.Client.ObjectStorage.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if err != nil {
+		if isBucketPolicyNotFound(err) || isNoSuchBucket(err) {
+			b.Policy = NewPolicyNull()
+			return
+		}
+		diag.AddError("GetBucketPolicy ERROR", err.Error())
+		return
+	}
+	if output == nil || output.Policy == nil {
+		diag.AddError("GetBucketPolicy ERROR", "output is nil")
+		return
+	}
+
+	b.Policy = NewPolicyValue(*output.Policy)
+}
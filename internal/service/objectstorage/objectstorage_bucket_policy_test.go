@@ -0,0 +1,137 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/service/objectstorage"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_policy_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_policy.testing_policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyConfig(bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", bucketName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy", "data.ncloud_objectstorage_policy_document.public_read", "json"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
+		},
+	})
+}
+
+func TestPolicyEquivalent(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     string
+		expected bool
+	}{
+		"key-order": {
+			a:        `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject"}]}`,
+			b:        `{"Statement": [{"Action": "s3:GetObject", "Effect": "Allow"}], "Version": "2012-10-17"}`,
+			expected: true,
+		},
+		"single-value-list": {
+			a:        `{"Statement": [{"Action": ["s3:GetObject"]}]}`,
+			b:        `{"Statement": {"Action": "s3:GetObject"}}`,
+			expected: true,
+		},
+		"string-list-order": {
+			a:        `{"Action": ["s3:GetObject", "s3:PutObject"]}`,
+			b:        `{"Action": ["s3:PutObject", "s3:GetObject"]}`,
+			expected: true,
+		},
+		"different-value": {
+			a:        `{"Action": "s3:GetObject"}`,
+			b:        `{"Action": "s3:PutObject"}`,
+			expected: false,
+		},
+		"invalid-json": {
+			a:        `{"Action": "s3:GetObject"}`,
+			b:        `{"Action":`,
+			expected: false,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := objectstorage.PolicyEquivalent(test.a, test.b); got != test.expected {
+				t.Fatalf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func testAccCheckBucketPolicyDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_objectstorage_bucket_policy" {
+			continue
+		}
+
+		_, err := config.Client.ObjectStorage.GetBucketPolicy(context.Background(), &s3.GetBucketPolicyInput{
+			Bucket: ncloud.String(rs.Primary.Attributes["bucket_name"]),
+		})
+		if err == nil {
+			return fmt.Errorf("bucket policy still exists")
+		}
+
+		if !strings.Contains(err.Error(), "NoSuchBucketPolicy") && !strings.Contains(err.Error(), "NoSuchBucket") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketPolicyConfig(bucketName string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		data "ncloud_objectstorage_policy_document" "public_read" {
+			statement = [
+				{
+					sid			= "PublicRead"
+					actions		= ["s3:GetObject", "s3:GetObjectVersion"]
+					resources	= ["arn:aws:s3:::%[1]s/*"]
+					principals	= [
+						{
+							type		= "*"
+							identifiers	= ["*"]
+						}
+					]
+				}
+			]
+		}
+
+		resource "ncloud_objectstorage_bucket_policy" "testing_policy" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+			policy					= data.ncloud_objectstorage_policy_document.public_read.json
+		}
+	`, bucketName)
+}
@@ -0,0 +1,406 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                   = &bucketServerSideEncryptionConfigurationResource{}
+	_ resource.ResourceWithConfigure      = &bucketServerSideEncryptionConfigurationResource{}
+	_ resource.ResourceWithImportState    = &bucketServerSideEncryptionConfigurationResource{}
+	_ resource.ResourceWithValidateConfig = &bucketServerSideEncryptionConfigurationResource{}
+	_ resource.ResourceWithModifyPlan     = &bucketServerSideEncryptionConfigurationResource{}
+)
+
+func NewBucketServerSideEncryptionConfigurationResource() resource.Resource {
+	return &bucketServerSideEncryptionConfigurationResource{}
+}
+
+type bucketServerSideEncryptionConfigurationResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"rule": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"sse_algorithm": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(awsTypes.ServerSideEncryptionAes256),
+								string(awsTypes.ServerSideEncryptionAwsKms),
+							),
+						},
+						Description: "Server-side encryption algorithm applied by default",
+					},
+					"kms_master_key_id": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						Description: "KMS key ID used when sse_algorithm is aws:kms, defaults to the key chosen by the service",
+					},
+				},
+			},
+		},
+	}
+}
+
+// KMS key is only meaningful for KMS-backed encryption, AES256 always uses the storage managed key
+func (b *bucketServerSideEncryptionConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config bucketServerSideEncryptionConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Rule == nil {
+		return
+	}
+
+	if config.Rule.SSEAlgorithm.IsUnknown() || config.Rule.KMSMasterKeyID.IsNull() {
+		return
+	}
+
+	if config.Rule.SSEAlgorithm.ValueString() != string(awsTypes.ServerSideEncryptionAwsKms) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rule").AtName("kms_master_key_id"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("kms_master_key_id can only be set when sse_algorithm is %s", awsTypes.ServerSideEncryptionAwsKms),
+		)
+	}
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config bucketServerSideEncryptionConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || plan.Rule == nil || config.Rule == nil {
+		return
+	}
+
+	// The key is only computed when it is left out of the configuration
+	if !config.Rule.KMSMasterKeyID.IsNull() || plan.Rule.SSEAlgorithm.IsUnknown() {
+		return
+	}
+
+	keyPath := path.Root("rule").AtName("kms_master_key_id")
+
+	if plan.Rule.SSEAlgorithm.ValueString() != string(awsTypes.ServerSideEncryptionAwsKms) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, keyPath, types.StringNull())...)
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state bucketServerSideEncryptionConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.Rule == nil {
+		return
+	}
+
+	// Keep the default key the service picked as long as KMS stays in use
+	if state.Rule.SSEAlgorithm.Equal(plan.Rule.SSEAlgorithm) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, keyPath, state.Rule.KMSMasterKeyID)...)
+	}
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketServerSideEncryptionConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketEncryption(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketEncryptionApplied(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketServerSideEncryptionConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.refreshFromOutput(ctx, b.config, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Rule == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bucketServerSideEncryptionConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketEncryption(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketEncryptionApplied(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketServerSideEncryptionConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketEncryptionInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketEncryption reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketEncryption(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketEncryption response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_server_side_encryption_configuration"
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketServerSideEncryptionConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketEncryption(ctx context.Context, config *conn.ProviderConfig, plan *bucketServerSideEncryptionConfigurationResourceModel) error {
+	algorithm := awsTypes.ServerSideEncryption(plan.Rule.SSEAlgorithm.ValueString())
+
+	reqParams := &s3.PutBucketEncryptionInput{
+		Bucket: plan.BucketName.ValueStringPointer(),
+		ServerSideEncryptionConfiguration: &awsTypes.ServerSideEncryptionConfiguration{
+			Rules: []awsTypes.ServerSideEncryptionRule{
+				{
+					ApplyServerSideEncryptionByDefault: &awsTypes.ServerSideEncryptionByDefault{
+						SSEAlgorithm:   algorithm,
+						KMSMasterKeyID: knownStringPointer(plan.Rule.KMSMasterKeyID),
+					},
+				},
+			},
+		},
+	}
+
+	tflog.Info(ctx, "PutBucketEncryption reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketEncryption(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketEncryption response="+common.MarshalUncheckedString(response))
+
+	return nil
+}
+
+// waitBucketEncryptionApplied waits until the bucket returns the planned rule, as the previous one is still returned for a while after an update.
+func waitBucketEncryptionApplied(ctx context.Context, config *conn.ProviderConfig, plan *bucketServerSideEncryptionConfigurationResourceModel) error {
+	bucketName := plan.BucketName.ValueString()
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{APPLYING},
+		Target:  []string{APPLIED},
+		Refresh: func() (interface{}, string, error) {
+			output, err := config.Client.ObjectStorage.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{
+				Bucket: ncloud.String(bucketName),
+			})
+
+			if output != nil && encryptionRuleMatches(output.ServerSideEncryptionConfiguration, plan.Rule) {
+				return output, APPLIED, nil
+			}
+
+			if err != nil {
+				return output, APPLYING, nil
+			}
+
+			return output, APPLYING, nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bucket server side encryption configuration (%s) to be applied: %s", bucketName, err)
+	}
+	return nil
+}
+
+// encryptionRuleMatches reports whether the default encryption of the configuration is the planned one.
+// A key ID left to the server is not compared.
+func encryptionRuleMatches(configuration *awsTypes.ServerSideEncryptionConfiguration, rule *serverSideEncryptionRuleModel) bool {
+	if configuration == nil || rule == nil {
+		return false
+	}
+
+	for _, r := range configuration.Rules {
+		if r.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+
+		applied := r.ApplyServerSideEncryptionByDefault
+		if string(applied.SSEAlgorithm) != rule.SSEAlgorithm.ValueString() {
+			return false
+		}
+
+		if isKnown(rule.KMSMasterKeyID) && ncloud.StringValue(applied.KMSMasterKeyID) != rule.KMSMasterKeyID.ValueString() {
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func isServerSideEncryptionConfigurationNotFound(err error) bool {
+	return strings.Contains(err.Error(), "ServerSideEncryptionConfigurationNotFoundError")
+}
+
+type bucketServerSideEncryptionConfigurationResourceModel struct {
+	ID         types.String                   `tfsdk:"id"`
+	BucketName types.String                   `tfsdk:"bucket_name"`
+	Rule       *serverSideEncryptionRuleModel `tfsdk:"rule"`
+}
+
+type serverSideEncryptionRuleModel struct {
+	SSEAlgorithm   types.String `tfsdk:"sse_algorithm"`
+	KMSMasterKeyID types.String `tfsdk:"kms_master_key_id"`
+}
+
+func (b *bucketServerSideEncryptionConfigurationResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, bucketName string, diag *diag.Diagnostics) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+
+	output, err := config.Client.ObjectStorage.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if err != nil {
+		if isServerSideEncryptionConfigurationNotFound(err) || isNoSuchBucket(err) {
+			b.Rule = nil
+			return
+		}
+		diag.AddError("GetBucketEncryption ERROR", err.Error())
+		return
+	}
+	if output == nil || output.ServerSideEncryptionConfiguration == nil {
+		diag.AddError("GetBucketEncryption ERROR", "output is nil")
+		return
+	}
+
+	b.Rule = nil
+	for _, rule := range output.ServerSideEncryptionConfiguration.Rules {
+		if rule.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+
+		b.Rule = &serverSideEncryptionRuleModel{
+			SSEAlgorithm:   types.StringValue(string(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm)),
+			KMSMasterKeyID: types.StringPointerValue(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
+		}
+		break
+	}
+}
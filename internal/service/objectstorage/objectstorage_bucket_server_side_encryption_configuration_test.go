@@ -0,0 +1,124 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_server_side_encryption_configuration_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_server_side_encryption_configuration.testing_sse"
+	objectName := "ncloud_objectstorage_object.testing_object"
+
+	tmpFile := CreateTempFile(t, "content for encryption testing", sourceName)
+	source := tmpFile.Name()
+	defer os.Remove(source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBucketServerSideEncryptionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketServerSideEncryptionConfigurationConfig(bucketName, sourceName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket_name", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "rule.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr(objectName, "server_side_encryption", "AES256"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_bucket_server_side_encryption_configuration_kmsKeyWithAES256(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketServerSideEncryptionConfigurationKMSKeyConfig(bucketName),
+				ExpectError: regexp.MustCompile("kms_master_key_id can only be set when sse_algorithm is aws:kms"),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketServerSideEncryptionConfigurationDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_objectstorage_bucket_server_side_encryption_configuration" {
+			continue
+		}
+
+		_, err := config.Client.ObjectStorage.GetBucketEncryption(context.Background(), &s3.GetBucketEncryptionInput{
+			Bucket: ncloud.String(rs.Primary.Attributes["bucket_name"]),
+		})
+		if err == nil {
+			return fmt.Errorf("bucket server side encryption configuration still exists")
+		}
+
+		if !strings.Contains(err.Error(), "ServerSideEncryptionConfigurationNotFoundError") && !strings.Contains(err.Error(), "NoSuchBucket") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketServerSideEncryptionConfigurationConfig(bucketName, key, source string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_server_side_encryption_configuration" "testing_sse" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+
+			rule = {
+				sse_algorithm		= "AES256"
+			}
+		}
+
+		resource "ncloud_objectstorage_object" "testing_object" {
+			bucket					= ncloud_objectstorage_bucket_server_side_encryption_configuration.testing_sse.bucket_name
+			key						= "%[2]s"
+			source					= "%[3]s"
+		}
+	`, bucketName, key, source)
+}
+
+func testAccBucketServerSideEncryptionConfigurationKMSKeyConfig(bucketName string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket_server_side_encryption_configuration" "testing_sse" {
+			bucket_name				= "%[1]s"
+
+			rule = {
+				sse_algorithm		= "AES256"
+				kms_master_key_id	= "test-key-id"
+			}
+		}
+	`, bucketName)
+}
@@ -0,0 +1,307 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketVersioningResource{}
+	_ resource.ResourceWithConfigure   = &bucketVersioningResource{}
+	_ resource.ResourceWithImportState = &bucketVersioningResource{}
+)
+
+func NewBucketVersioningResource() resource.Resource {
+	return &bucketVersioningResource{}
+}
+
+type bucketVersioningResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketVersioningResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"status": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(awsTypes.BucketVersioningStatusEnabled),
+						string(awsTypes.BucketVersioningStatusSuspended),
+					),
+				},
+			},
+			"mfa_delete": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(awsTypes.MFADeleteEnabled),
+						string(awsTypes.MFADeleteDisabled),
+					),
+				},
+			},
+			"mfa": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Concatenation of the authentication device's serial number, a space, and the value displayed on the device",
+			},
+		},
+	}
+}
+
+func (b *bucketVersioningResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketVersioningResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketVersioning(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketVersioningApplied(ctx, b.config, plan.BucketName.ValueString(), plan.Status.ValueString()); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketVersioningResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketVersioningResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.refreshFromOutput(ctx, b.config, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The bucket is gone or versioning has never been enabled on it
+	if state.Status.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketVersioningResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketVersioningResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Status.Equal(state.Status) || !plan.MFADelete.Equal(state.MFADelete) {
+		if err := putBucketVersioning(ctx, b.config, &plan); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+
+		if err := waitBucketVersioningApplied(ctx, b.config, plan.BucketName.ValueString(), plan.Status.ValueString()); err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+			return
+		}
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Versioning cannot be turned off once it has been enabled, so deleting the resource suspends it.
+func (b *bucketVersioningResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketVersioningResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() == string(awsTypes.BucketVersioningStatusSuspended) {
+		return
+	}
+
+	state.Status = types.StringValue(string(awsTypes.BucketVersioningStatusSuspended))
+
+	if err := putBucketVersioning(ctx, b.config, &state); err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketVersioningApplied(ctx, b.config, state.BucketName.ValueString(), state.Status.ValueString()); err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
+	}
+}
+
+func (b *bucketVersioningResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_versioning"
+}
+
+func (b *bucketVersioningResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketVersioningResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketVersioning(ctx context.Context, config *conn.ProviderConfig, plan *bucketVersioningResourceModel) error {
+	reqParams := &s3.PutBucketVersioningInput{
+		Bucket: plan.BucketName.ValueStringPointer(),
+		VersioningConfiguration: &awsTypes.VersioningConfiguration{
+			Status: awsTypes.BucketVersioningStatus(plan.Status.ValueString()),
+		},
+	}
+
+	if !plan.MFADelete.IsNull() && !plan.MFADelete.IsUnknown() {
+		reqParams.VersioningConfiguration.MFADelete = awsTypes.MFADelete(plan.MFADelete.ValueString())
+	}
+
+	if !plan.MFA.IsNull() && !plan.MFA.IsUnknown() {
+		reqParams.MFA = plan.MFA.ValueStringPointer()
+	}
+
+	tflog.Info(ctx, "PutBucketVersioning reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketVersioning(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketVersioning response="+common.MarshalUncheckedString(response))
+
+	return nil
+}
+
+func waitBucketVersioningApplied(ctx context.Context, config *conn.ProviderConfig, bucketName, status string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{APPLYING},
+		Target:  []string{APPLIED},
+		Refresh: func() (interface{}, string, error) {
+			output, err := config.Client.ObjectStorage.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+				Bucket: ncloud.String(bucketName),
+			})
+
+			if output != nil && string(output.Status) == status {
+				return output, APPLIED, nil
+			}
+
+			if err != nil {
+				return output, APPLYING, nil
+			}
+
+			return output, APPLYING, nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bucket versioning (%s) to be applied: %s", bucketName, err)
+	}
+	return nil
+}
+
+type bucketVersioningResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	BucketName types.String `tfsdk:"bucket_name"`
+	Status     types.String `tfsdk:"status"`
+	MFADelete  types.String `tfsdk:"mfa_delete"`
+	MFA        types.String `tfsdk:"mfa"`
+}
+
+func (b *bucketVersioningResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, bucketName string, diag *diag.Diagnostics) {
+	output, err := config.Client.ObjectStorage.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if err != nil {
+		if isNoSuchBucket(err) {
+			b.Status = types.StringNull()
+			return
+		}
+		diag.AddError("GetBucketVersioning ERROR", err.Error())
+		return
+	}
+	if output == nil {
+		diag.AddError("GetBucketVersioning ERROR", "output is nil")
+		return
+	}
+
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+
+	if output.Status != "" {
+		b.Status = types.StringValue(string(output.Status))
+	} else {
+		b.Status = types.StringNull()
+	}
+
+	if output.MFADelete != "" {
+		b.MFADelete = types.StringValue(string(output.MFADelete))
+	} else if b.MFADelete.IsUnknown() {
+		b.MFADelete = types.StringNull()
+	}
+}
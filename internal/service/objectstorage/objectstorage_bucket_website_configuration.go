@@ -0,0 +1,512 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &bucketWebsiteConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &bucketWebsiteConfigurationResource{}
+	_ resource.ResourceWithImportState = &bucketWebsiteConfigurationResource{}
+)
+
+func NewBucketWebsiteConfigurationResource() resource.Resource {
+	return &bucketWebsiteConfigurationResource{}
+}
+
+type bucketWebsiteConfigurationResource struct {
+	config *conn.ProviderConfig
+}
+
+func (b *bucketWebsiteConfigurationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	protocolValidators := []validator.String{
+		stringvalidator.OneOf("http", "https"),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Target bucket name",
+			},
+			"index_document": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"suffix": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("redirect_all_requests_to"),
+					),
+				},
+			},
+			"error_document": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName("redirect_all_requests_to"),
+					),
+				},
+			},
+			"redirect_all_requests_to": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"host_name": schema.StringAttribute{
+						Required: true,
+					},
+					"protocol": schema.StringAttribute{
+						Optional:   true,
+						Validators: protocolValidators,
+					},
+				},
+			},
+			"routing_rule": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"condition": schema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"http_error_code_returned_equals": schema.StringAttribute{
+									Optional: true,
+								},
+								"key_prefix_equals": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						"redirect": schema.SingleNestedAttribute{
+							Required: true,
+							Attributes: map[string]schema.Attribute{
+								"host_name": schema.StringAttribute{
+									Optional: true,
+								},
+								"http_redirect_code": schema.StringAttribute{
+									Optional: true,
+								},
+								"protocol": schema.StringAttribute{
+									Optional:   true,
+									Validators: protocolValidators,
+								},
+								"replace_key_prefix_with": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										stringvalidator.ConflictsWith(
+											path.MatchRelative().AtParent().AtName("replace_key_with"),
+										),
+									},
+								},
+								"replace_key_with": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(
+						path.MatchRelative().AtParent().AtName("redirect_all_requests_to"),
+					),
+				},
+			},
+			"endpoint_domain": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Object Storage host name of the provider site and region",
+			},
+			"bucket_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Virtual-hosted endpoint of the bucket. It is the S3 API host, not a dedicated static website endpoint",
+			},
+		},
+	}
+}
+
+func (b *bucketWebsiteConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketWebsiteConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketWebsite(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketWebsiteApplied(ctx, b.config, plan.BucketName.ValueString()); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketWebsiteConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bucketWebsiteConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.refreshFromOutput(ctx, b.config, state.ID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.BucketEndpoint.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (b *bucketWebsiteConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bucketWebsiteConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putBucketWebsite(ctx, b.config, &plan); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	if err := waitBucketWebsiteApplied(ctx, b.config, plan.BucketName.ValueString()); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	plan.refreshFromOutput(ctx, b.config, plan.BucketName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (b *bucketWebsiteConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bucketWebsiteConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqParams := &s3.DeleteBucketWebsiteInput{
+		Bucket: state.BucketName.ValueStringPointer(),
+	}
+
+	tflog.Info(ctx, "DeleteBucketWebsite reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := b.config.Client.ObjectStorage.DeleteBucketWebsite(ctx, reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "DeleteBucketWebsite response="+common.MarshalUncheckedString(response))
+}
+
+func (b *bucketWebsiteConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_bucket_website_configuration"
+}
+
+func (b *bucketWebsiteConfigurationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	b.config = config
+}
+
+func (b *bucketWebsiteConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func putBucketWebsite(ctx context.Context, config *conn.ProviderConfig, plan *bucketWebsiteConfigurationResourceModel) error {
+	reqParams := &s3.PutBucketWebsiteInput{
+		Bucket:               plan.BucketName.ValueStringPointer(),
+		WebsiteConfiguration: plan.toWebsiteConfiguration(),
+	}
+
+	tflog.Info(ctx, "PutBucketWebsite reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := config.Client.ObjectStorage.PutBucketWebsite(ctx, reqParams)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "PutBucketWebsite response="+common.MarshalUncheckedString(response))
+
+	return nil
+}
+
+func waitBucketWebsiteApplied(ctx context.Context, config *conn.ProviderConfig, bucketName string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{APPLYING},
+		Target:  []string{APPLIED},
+		Refresh: func() (interface{}, string, error) {
+			output, err := config.Client.ObjectStorage.GetBucketWebsite(ctx, &s3.GetBucketWebsiteInput{
+				Bucket: ncloud.String(bucketName),
+			})
+
+			if output != nil {
+				return output, APPLIED, nil
+			}
+
+			if err != nil {
+				return output, APPLYING, nil
+			}
+
+			return output, APPLYING, nil
+		},
+		Timeout:    conn.DefaultTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for bucket website configuration (%s) to be applied: %s", bucketName, err)
+	}
+	return nil
+}
+
+func isWebsiteConfigurationNotFound(err error) bool {
+	return strings.Contains(err.Error(), "NoSuchWebsiteConfiguration")
+}
+
+type bucketWebsiteConfigurationResourceModel struct {
+	ID                    types.String          `tfsdk:"id"`
+	BucketName            types.String          `tfsdk:"bucket_name"`
+	IndexDocument         *websiteIndexDocument `tfsdk:"index_document"`
+	ErrorDocument         *websiteErrorDocument `tfsdk:"error_document"`
+	RedirectAllRequestsTo *websiteRedirectAll   `tfsdk:"redirect_all_requests_to"`
+	RoutingRule           []websiteRoutingRule  `tfsdk:"routing_rule"`
+	EndpointDomain        types.String          `tfsdk:"endpoint_domain"`
+	BucketEndpoint        types.String          `tfsdk:"bucket_endpoint"`
+}
+
+type websiteIndexDocument struct {
+	Suffix types.String `tfsdk:"suffix"`
+}
+
+type websiteErrorDocument struct {
+	Key types.String `tfsdk:"key"`
+}
+
+type websiteRedirectAll struct {
+	HostName types.String `tfsdk:"host_name"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+type websiteRoutingRule struct {
+	Condition *websiteRoutingRuleCondition `tfsdk:"condition"`
+	Redirect  *websiteRoutingRuleRedirect  `tfsdk:"redirect"`
+}
+
+type websiteRoutingRuleCondition struct {
+	HttpErrorCodeReturnedEquals types.String `tfsdk:"http_error_code_returned_equals"`
+	KeyPrefixEquals             types.String `tfsdk:"key_prefix_equals"`
+}
+
+type websiteRoutingRuleRedirect struct {
+	HostName             types.String `tfsdk:"host_name"`
+	HttpRedirectCode     types.String `tfsdk:"http_redirect_code"`
+	Protocol             types.String `tfsdk:"protocol"`
+	ReplaceKeyPrefixWith types.String `tfsdk:"replace_key_prefix_with"`
+	ReplaceKeyWith       types.String `tfsdk:"replace_key_with"`
+}
+
+func (b *bucketWebsiteConfigurationResourceModel) toWebsiteConfiguration() *awsTypes.WebsiteConfiguration {
+	website := &awsTypes.WebsiteConfiguration{}
+
+	if b.IndexDocument != nil {
+		website.IndexDocument = &awsTypes.IndexDocument{
+			Suffix: b.IndexDocument.Suffix.ValueStringPointer(),
+		}
+	}
+
+	if b.ErrorDocument != nil {
+		website.ErrorDocument = &awsTypes.ErrorDocument{
+			Key: b.ErrorDocument.Key.ValueStringPointer(),
+		}
+	}
+
+	if b.RedirectAllRequestsTo != nil {
+		website.RedirectAllRequestsTo = &awsTypes.RedirectAllRequestsTo{
+			HostName: b.RedirectAllRequestsTo.HostName.ValueStringPointer(),
+			Protocol: awsTypes.Protocol(b.RedirectAllRequestsTo.Protocol.ValueString()),
+		}
+	}
+
+	for _, r := range b.RoutingRule {
+		rule := awsTypes.RoutingRule{}
+
+		if r.Condition != nil {
+			rule.Condition = &awsTypes.Condition{
+				HttpErrorCodeReturnedEquals: r.Condition.HttpErrorCodeReturnedEquals.ValueStringPointer(),
+				KeyPrefixEquals:             r.Condition.KeyPrefixEquals.ValueStringPointer(),
+			}
+		}
+
+		if r.Redirect != nil {
+			rule.Redirect = &awsTypes.Redirect{
+				HostName:             r.Redirect.HostName.ValueStringPointer(),
+				HttpRedirectCode:     r.Redirect.HttpRedirectCode.ValueStringPointer(),
+				Protocol:             awsTypes.Protocol(r.Redirect.Protocol.ValueString()),
+				ReplaceKeyPrefixWith: r.Redirect.ReplaceKeyPrefixWith.ValueStringPointer(),
+				ReplaceKeyWith:       r.Redirect.ReplaceKeyWith.ValueStringPointer(),
+			}
+		}
+
+		website.RoutingRules = append(website.RoutingRules, rule)
+	}
+
+	return website
+}
+
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+func (b *bucketWebsiteConfigurationResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, bucketName string, diag *diag.Diagnostics) {
+	b.ID = types.StringValue(bucketName)
+	b.BucketName = types.StringValue(bucketName)
+
+	output, err := config.Client.ObjectStorage.GetBucketWebsite(ctx, &s3.GetBucketWebsiteInput{
+		Bucket: ncloud.String(bucketName),
+	})
+	if err != nil {
+		if isWebsiteConfigurationNotFound(err) || isNoSuchBucket(err) {
+			b.BucketEndpoint = types.StringNull()
+			return
+		}
+		diag.AddError("GetBucketWebsite ERROR", err.Error())
+		return
+	}
+	if output == nil {
+		diag.AddError("GetBucketWebsite ERROR", "output is nil")
+		return
+	}
+
+	b.IndexDocument = nil
+	if output.IndexDocument != nil {
+		b.IndexDocument = &websiteIndexDocument{
+			Suffix: types.StringPointerValue(output.IndexDocument.Suffix),
+		}
+	}
+
+	b.ErrorDocument = nil
+	if output.ErrorDocument != nil {
+		b.ErrorDocument = &websiteErrorDocument{
+			Key: types.StringPointerValue(output.ErrorDocument.Key),
+		}
+	}
+
+	b.RedirectAllRequestsTo = nil
+	if output.RedirectAllRequestsTo != nil {
+		b.RedirectAllRequestsTo = &websiteRedirectAll{
+			HostName: types.StringPointerValue(output.RedirectAllRequestsTo.HostName),
+			Protocol: stringValueOrNull(string(output.RedirectAllRequestsTo.Protocol)),
+		}
+	}
+
+	b.RoutingRule = nil
+	for _, r := range output.RoutingRules {
+		rule := websiteRoutingRule{}
+
+		if r.Condition != nil {
+			rule.Condition = &websiteRoutingRuleCondition{
+				HttpErrorCodeReturnedEquals: types.StringPointerValue(r.Condition.HttpErrorCodeReturnedEquals),
+				KeyPrefixEquals:             types.StringPointerValue(r.Condition.KeyPrefixEquals),
+			}
+		}
+
+		if r.Redirect != nil {
+			rule.Redirect = &websiteRoutingRuleRedirect{
+				HostName:             types.StringPointerValue(r.Redirect.HostName),
+				HttpRedirectCode:     types.StringPointerValue(r.Redirect.HttpRedirectCode),
+				Protocol:             stringValueOrNull(string(r.Redirect.Protocol)),
+				ReplaceKeyPrefixWith: types.StringPointerValue(r.Redirect.ReplaceKeyPrefixWith),
+				ReplaceKeyWith:       types.StringPointerValue(r.Redirect.ReplaceKeyWith),
+			}
+		}
+
+		b.RoutingRule = append(b.RoutingRule, rule)
+	}
+
+	domain := conn.GenObjectStorageDomain(config.RegionCode, config.Site)
+	b.EndpointDomain = types.StringValue(domain)
+	b.BucketEndpoint = types.StringValue(bucketName + "." + domain)
+}
@@ -0,0 +1,146 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_bucket_website_configuration_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_website_configuration.testing_website"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBucketWebsiteConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketWebsiteConfigurationConfig(bucketName, "index.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "index_document.suffix", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "error_document.key", "error.html"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.0.condition.key_prefix_equals", "docs/"),
+					resource.TestCheckResourceAttr(resourceName, "routing_rule.0.redirect.replace_key_prefix_with", "documents/"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_domain"),
+					resource.TestMatchResourceAttr(resourceName, "bucket_endpoint", regexp.MustCompile(`^`+bucketName+`\.`)),
+				),
+			},
+			{
+				Config: testAccBucketWebsiteConfigurationConfig(bucketName, "main.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "index_document.suffix", "main.html"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_bucket_website_configuration_redirect(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_bucket_website_configuration.testing_website"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckBucketWebsiteConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketWebsiteConfigurationRedirectConfig(bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to.host_name", "www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to.protocol", "https"),
+					resource.TestCheckNoResourceAttr(resourceName, "index_document.suffix"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketWebsiteConfigurationDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_objectstorage_bucket_website_configuration" {
+			continue
+		}
+
+		_, err := config.Client.ObjectStorage.GetBucketWebsite(context.Background(), &s3.GetBucketWebsiteInput{
+			Bucket: ncloud.String(rs.Primary.Attributes["bucket_name"]),
+		})
+		if err == nil {
+			return fmt.Errorf("bucket website configuration still exists")
+		}
+
+		if !strings.Contains(err.Error(), "NoSuchWebsiteConfiguration") && !strings.Contains(err.Error(), "NoSuchBucket") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccBucketWebsiteConfigurationConfig(bucketName, indexDocument string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_website_configuration" "testing_website" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+
+			index_document = {
+				suffix				= "%[2]s"
+			}
+
+			error_document = {
+				key					= "error.html"
+			}
+
+			routing_rule = [
+				{
+					condition = {
+						key_prefix_equals		= "docs/"
+					}
+					redirect = {
+						replace_key_prefix_with	= "documents/"
+					}
+				}
+			]
+		}
+	`, bucketName, indexDocument)
+}
+
+func testAccBucketWebsiteConfigurationRedirectConfig(bucketName string) string {
+	return fmt.Sprintf(`
+		resource "ncloud_objectstorage_bucket" "testing_bucket" {
+			bucket_name				= "%[1]s"
+		}
+
+		resource "ncloud_objectstorage_bucket_website_configuration" "testing_website" {
+			bucket_name				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+
+			redirect_all_requests_to = {
+				host_name			= "www.example.com"
+				protocol			= "https"
+			}
+		}
+	`, bucketName)
+}
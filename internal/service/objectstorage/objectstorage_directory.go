@@ -0,0 +1,807 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+const (
+	// DeleteObjects accepts at most 1000 keys per request
+	deleteObjectsBatchSize = 1000
+
+	// Files uploaded at the same time, each of them may in turn be uploaded in parts
+	directoryUploadConcurrency = 5
+)
+
+var (
+	_ resource.Resource                   = &directoryResource{}
+	_ resource.ResourceWithConfigure      = &directoryResource{}
+	_ resource.ResourceWithImportState    = &directoryResource{}
+	_ resource.ResourceWithModifyPlan     = &directoryResource{}
+	_ resource.ResourceWithValidateConfig = &directoryResource{}
+)
+
+func NewDirectoryResource() resource.Resource {
+	return &directoryResource{}
+}
+
+type directoryResource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *directoryResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"bucket": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators:  BucketNameValidator(),
+				Description: "Bucket name to sync the directory to",
+			},
+			"prefix": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Key prefix the files are uploaded under",
+			},
+			"source_dir": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Path of the local directory",
+			},
+			"delete_stale": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete keys under the prefix that do not exist in the local directory, requires prefix",
+			},
+			"files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "ETag expected for each synced file, keyed by its path relative to the prefix",
+			},
+			"encrypted_files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Last modification time of each synced file encrypted with a KMS key, keyed by its path relative to the prefix",
+			},
+			"changes": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"added": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"changed": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"removed": schema.ListAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+				Description: "Keys added, changed and removed by the latest sync",
+			},
+		},
+	}
+}
+
+func (d *directoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan directoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, err := scanDirectory(plan.SourceDir.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	changes := diffDirectoryFiles(files, nil, false)
+	encrypted, err := syncDirectory(ctx, d.config, &plan, changes)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	plan.ID = plan.Bucket
+	if !plan.Prefix.IsNull() {
+		plan.ID = types.StringValue(plan.Bucket.ValueString() + "/" + plan.Prefix.ValueString())
+	}
+	plan.setFiles(ctx, files, mergeEncryptedFiles(nil, encrypted, files, changes), changes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (d *directoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state directoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.refreshFromOutput(ctx, d.config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Files.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *directoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state directoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, err := scanDirectory(plan.SourceDir.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	previous := make(map[string]string)
+	resp.Diagnostics.Append(state.Files.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousEncrypted := make(map[string]string)
+	if !state.EncryptedFiles.IsNull() {
+		resp.Diagnostics.Append(state.EncryptedFiles.ElementsAs(ctx, &previousEncrypted, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	changes := diffDirectoryFiles(files, previous, plan.DeleteStale.ValueBool())
+	encrypted, err := syncDirectory(ctx, d.config, &plan, changes)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	if changes.empty() {
+		plan.Files = state.Files
+		plan.EncryptedFiles = state.EncryptedFiles
+		plan.Changes = state.Changes
+	} else {
+		plan.setFiles(ctx, files, mergeEncryptedFiles(previousEncrypted, encrypted, files, changes), changes, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (d *directoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state directoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := make(map[string]string)
+	resp.Diagnostics.Append(state.Files.ElementsAs(ctx, &files, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	for name := range files {
+		keys = append(keys, name)
+	}
+
+	if err := deleteDirectoryObjects(ctx, d.config, &state, keys); err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+	}
+}
+
+// Deleting stale keys at the bucket root would remove every object the directory does not manage
+func (d *directoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config directoryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DeleteStale.ValueBool() && config.Prefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prefix"),
+			"Missing Attribute Configuration",
+			"prefix must be set when delete_stale is true",
+		)
+	}
+}
+
+func (d *directoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceDir.IsUnknown() || plan.DeleteStale.IsUnknown() {
+		plan.setFilesUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	files, err := scanDirectory(plan.SourceDir.ValueString())
+	if errors.Is(err, fs.ErrNotExist) {
+		// The directory may be created during apply, it is scanned again then
+		plan.setFilesUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_dir"), "Invalid Source Directory", err.Error())
+		return
+	}
+
+	previous := make(map[string]string)
+	if !req.State.Raw.IsNull() {
+		var state directoryResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		resp.Diagnostics.Append(state.Files.ElementsAs(ctx, &previous, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Keep the latest changes when the directory is in sync, so an unchanged directory plans no update
+		if diffDirectoryFiles(files, previous, plan.DeleteStale.ValueBool()).empty() {
+			plan.Files = state.Files
+			plan.EncryptedFiles = state.EncryptedFiles
+			plan.Changes = state.Changes
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	changes := diffDirectoryFiles(files, previous, plan.DeleteStale.ValueBool())
+	plan.setFiles(ctx, files, nil, changes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Modification times of encrypted objects are known once they are uploaded
+	plan.EncryptedFiles = types.MapUnknown(types.StringType)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (d *directoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_directory"
+}
+
+func (d *directoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Exprected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *directoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	bucketName, prefix, _ := strings.Cut(req.ID, "/")
+
+	if bucketName == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: bucket-name or bucket-name/prefix Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bucket"), bucketName)...)
+	if prefix != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prefix"), prefix)...)
+	}
+}
+
+// scanDirectory returns the ETag expected for every regular file in dir once uploaded, keyed by its slash separated relative path.
+// It is the MD5 digest of the file, or the multipart ETag of files uploaded in parts.
+func scanDirectory(dir string) (map[string]string, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	files := make(map[string]string)
+	err = filepath.WalkDir(dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// follow symbolic links to files, skip anything else that is not a regular file
+		info, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		partSize := info.Size()
+		if partSize >= DefaultMultipartThreshold {
+			partSize = multipartPartSize(types.Int64Null(), info.Size())
+		}
+
+		hash, err := multipartETag(file, info.Size(), partSize)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// diffDirectoryFiles compares the local files with the previously synced ones.
+// Files that only exist in previous are removed only when deleteStale is set.
+func diffDirectoryFiles(files, previous map[string]string, deleteStale bool) directoryChangeSet {
+	var changes directoryChangeSet
+
+	for name, hash := range files {
+		previousHash, ok := previous[name]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, name)
+		case previousHash != hash:
+			changes.Changed = append(changes.Changed, name)
+		}
+	}
+
+	for name := range previous {
+		if _, ok := files[name]; ok {
+			continue
+		}
+
+		if deleteStale {
+			changes.Removed = append(changes.Removed, name)
+		} else {
+			changes.Released = append(changes.Released, name)
+		}
+	}
+
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Changed)
+	sort.Strings(changes.Removed)
+	sort.Strings(changes.Released)
+
+	return changes
+}
+
+// syncDirectory uploads the added and changed files, at most directoryUploadConcurrency at a time, then deletes the removed ones.
+// It returns the last modification time of the uploaded files that are encrypted with a KMS key.
+func syncDirectory(ctx context.Context, config *conn.ProviderConfig, plan *directoryResourceModel, changes directoryChangeSet) (map[string]string, error) {
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	encrypted := make(map[string]string)
+	sem := make(chan struct{}, directoryUploadConcurrency)
+
+	for _, name := range append(append([]string{}, changes.Added...), changes.Changed...) {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(name string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			lastModified, err := uploadDirectoryFile(ctx, config, plan, name)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				return
+			}
+			if lastModified != "" {
+				encrypted[name] = lastModified
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return encrypted, deleteDirectoryObjects(ctx, config, plan, changes.Removed)
+}
+
+// uploadDirectoryFile uploads the file and returns its last modification time in the bucket when it is encrypted with a KMS key,
+// as the ETag of such an object is not an MD5 digest to compare with.
+func uploadDirectoryFile(ctx context.Context, config *conn.ProviderConfig, plan *directoryResourceModel, name string) (string, error) {
+	file, err := os.Open(filepath.Join(plan.SourceDir.ValueString(), filepath.FromSlash(name)))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	contentType, err := detectContentType(file, name)
+	if err != nil {
+		return "", err
+	}
+
+	reqParams := &s3.PutObjectInput{
+		Bucket:      plan.Bucket.ValueStringPointer(),
+		Key:         ncloud.String(plan.objectKey(name)),
+		Body:        file,
+		ContentType: ncloud.String(contentType),
+	}
+
+	_, encryption, err := uploadObject(ctx, config, multipartUploadOptions{}, reqParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload %s: %s", name, err)
+	}
+
+	if encryption != awsTypes.ServerSideEncryptionAwsKms {
+		return "", nil
+	}
+
+	output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: reqParams.Bucket,
+		Key:    reqParams.Key,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s after upload: %s", name, err)
+	}
+	if output.LastModified == nil {
+		return "", fmt.Errorf("failed to read %s after upload: last modified is missing", name)
+	}
+
+	return output.LastModified.UTC().Format(time.RFC3339), nil
+}
+
+// mergeEncryptedFiles keeps the modification times of encrypted files that are still synced and were not uploaded again,
+// and adds those of the uploaded ones.
+func mergeEncryptedFiles(previous, uploaded, files map[string]string, changes directoryChangeSet) map[string]string {
+	merged := make(map[string]string)
+	for name, lastModified := range previous {
+		if _, ok := files[name]; ok {
+			merged[name] = lastModified
+		}
+	}
+
+	for _, name := range append(append([]string{}, changes.Added...), changes.Changed...) {
+		delete(merged, name)
+		if lastModified, ok := uploaded[name]; ok {
+			merged[name] = lastModified
+		}
+	}
+
+	return merged
+}
+
+// detectContentType guesses the content type from the file extension, then from the first 512 bytes of the content.
+func detectContentType(file io.ReadSeeker, name string) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(name)); contentType != "" {
+		return contentType, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+func deleteDirectoryObjects(ctx context.Context, config *conn.ProviderConfig, model *directoryResourceModel, names []string) error {
+	for start := 0; start < len(names); start += deleteObjectsBatchSize {
+		end := min(start+deleteObjectsBatchSize, len(names))
+
+		var objects []awsTypes.ObjectIdentifier
+		for _, name := range names[start:end] {
+			objects = append(objects, awsTypes.ObjectIdentifier{
+				Key: ncloud.String(model.objectKey(name)),
+			})
+		}
+
+		reqParams := &s3.DeleteObjectsInput{
+			Bucket: model.Bucket.ValueStringPointer(),
+			Delete: &awsTypes.Delete{
+				Objects: objects,
+				Quiet:   ncloud.Bool(true),
+			},
+		}
+
+		tflog.Info(ctx, "DeleteObjects reqParams="+common.MarshalUncheckedString(reqParams))
+
+		response, err := config.Client.ObjectStorage.DeleteObjects(ctx, reqParams)
+		if err != nil {
+			return err
+		}
+
+		tflog.Info(ctx, "DeleteObjects response="+common.MarshalUncheckedString(response))
+
+		if len(response.Errors) > 0 {
+			e := response.Errors[0]
+			return fmt.Errorf("failed to delete %d objects, %s: %s", len(response.Errors), ncloud.StringValue(e.Key), ncloud.StringValue(e.Message))
+		}
+	}
+
+	return nil
+}
+
+type directoryResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Bucket         types.String `tfsdk:"bucket"`
+	Prefix         types.String `tfsdk:"prefix"`
+	SourceDir      types.String `tfsdk:"source_dir"`
+	DeleteStale    types.Bool   `tfsdk:"delete_stale"`
+	Files          types.Map    `tfsdk:"files"`
+	EncryptedFiles types.Map    `tfsdk:"encrypted_files"`
+	Changes        types.Object `tfsdk:"changes"`
+}
+
+type directoryChanges struct {
+	Added   types.List `tfsdk:"added"`
+	Changed types.List `tfsdk:"changed"`
+	Removed types.List `tfsdk:"removed"`
+}
+
+func (d directoryChanges) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"added":   types.ListType{ElemType: types.StringType},
+		"changed": types.ListType{ElemType: types.StringType},
+		"removed": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// directoryChangeSet holds the relative paths to sync. Released files are no longer managed but kept in the bucket.
+type directoryChangeSet struct {
+	Added    []string
+	Changed  []string
+	Removed  []string
+	Released []string
+}
+
+func (c directoryChangeSet) empty() bool {
+	return len(c.Added) == 0 && len(c.Changed) == 0 && len(c.Removed) == 0 && len(c.Released) == 0
+}
+
+func (d *directoryResourceModel) objectKey(name string) string {
+	prefix := d.Prefix.ValueString()
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	return prefix + name
+}
+
+func (d *directoryResourceModel) setFiles(ctx context.Context, files, encrypted map[string]string, changes directoryChangeSet, diag *diag.Diagnostics) {
+	fileMap, diags := types.MapValueFrom(ctx, types.StringType, files)
+	if diags.HasError() {
+		diag.Append(diags...)
+		return
+	}
+
+	encryptedMap, diags := types.MapValueFrom(ctx, types.StringType, encrypted)
+	if diags.HasError() {
+		diag.Append(diags...)
+		return
+	}
+
+	toList := func(values []string) types.List {
+		list, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, values...))
+		diag.Append(diags...)
+		return list
+	}
+
+	changesObject, diags := types.ObjectValueFrom(ctx, directoryChanges{}.attrTypes(), directoryChanges{
+		Added:   toList(changes.Added),
+		Changed: toList(changes.Changed),
+		Removed: toList(changes.Removed),
+	})
+	if diags.HasError() {
+		diag.Append(diags...)
+		return
+	}
+
+	d.Files = fileMap
+	d.EncryptedFiles = encryptedMap
+	d.Changes = changesObject
+}
+
+func (d *directoryResourceModel) setFilesUnknown() {
+	d.Files = types.MapUnknown(types.StringType)
+	d.EncryptedFiles = types.MapUnknown(types.StringType)
+	d.Changes = types.ObjectUnknown(directoryChanges{}.attrTypes())
+}
+
+// refreshFromOutput drops files that no longer exist in the bucket, so they are uploaded again, and records the
+// remote ETag of files changed outside of Terraform, so they are uploaded again as changed files.
+// Files encrypted with a KMS key are compared by their last modification time instead, as their ETag is not an MD5 digest.
+// With delete_stale, unmanaged keys under the prefix are recorded to be deleted. After import, every key is adopted.
+func (d *directoryResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, diag *diag.Diagnostics) {
+	adopt := d.Files.IsNull()
+
+	files := make(map[string]string)
+	if !adopt {
+		diag.Append(d.Files.ElementsAs(ctx, &files, false)...)
+		if diag.HasError() {
+			return
+		}
+	}
+
+	encrypted := make(map[string]string)
+	if !d.EncryptedFiles.IsNull() && !d.EncryptedFiles.IsUnknown() {
+		diag.Append(d.EncryptedFiles.ElementsAs(ctx, &encrypted, false)...)
+		if diag.HasError() {
+			return
+		}
+	}
+
+	listPrefix := d.objectKey("")
+	refreshed := make(map[string]string)
+	refreshedEncrypted := make(map[string]string)
+
+	paginator := s3.NewListObjectsV2Paginator(config.Client.ObjectStorage, &s3.ListObjectsV2Input{
+		Bucket: d.Bucket.ValueStringPointer(),
+		Prefix: ncloud.String(listPrefix),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			if isNoSuchBucket(err) {
+				d.Files = types.MapNull(types.StringType)
+				return
+			}
+			diag.AddError("ListObjectsV2 ERROR", err.Error())
+			return
+		}
+
+		for _, object := range output.Contents {
+			name := strings.TrimPrefix(ncloud.StringValue(object.Key), listPrefix)
+			if name == "" || strings.HasSuffix(name, "/") {
+				continue
+			}
+
+			hash, ok := files[name]
+			if !ok && !adopt && !d.DeleteStale.ValueBool() {
+				continue
+			}
+
+			if lastModified, ok := encrypted[name]; ok {
+				refreshedEncrypted[name] = lastModified
+				if object.LastModified != nil && object.LastModified.UTC().Format(time.RFC3339) == lastModified {
+					refreshed[name] = hash
+					continue
+				}
+			}
+
+			refreshed[name] = strings.Trim(ncloud.StringValue(object.ETag), `"`)
+		}
+	}
+
+	if d.Changes.IsNull() || d.Changes.IsUnknown() {
+		d.setFiles(ctx, refreshed, refreshedEncrypted, directoryChangeSet{}, diag)
+		return
+	}
+
+	fileMap, diags := types.MapValueFrom(ctx, types.StringType, refreshed)
+	if diags.HasError() {
+		diag.Append(diags...)
+		return
+	}
+
+	encryptedMap, diags := types.MapValueFrom(ctx, types.StringType, refreshedEncrypted)
+	if diags.HasError() {
+		diag.Append(diags...)
+		return
+	}
+
+	d.Files = fileMap
+	d.EncryptedFiles = encryptedMap
+}
@@ -0,0 +1,135 @@
+package objectstorage
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffDirectoryFiles(t *testing.T) {
+	files := map[string]string{
+		"index.html":    "a",
+		"css/main.css":  "b",
+		"js/main.js":    "c",
+		"img/logo.png":  "d",
+		"img/photo.jpg": "e",
+	}
+	previous := map[string]string{
+		"index.html":   "a",
+		"css/main.css": "old",
+		"old.html":     "f",
+	}
+
+	changes := diffDirectoryFiles(files, previous, false)
+
+	if !reflect.DeepEqual(changes.Added, []string{"img/logo.png", "img/photo.jpg", "js/main.js"}) {
+		t.Fatalf("unexpected added files: %v", changes.Added)
+	}
+	if !reflect.DeepEqual(changes.Changed, []string{"css/main.css"}) {
+		t.Fatalf("unexpected changed files: %v", changes.Changed)
+	}
+	if len(changes.Removed) != 0 || !reflect.DeepEqual(changes.Released, []string{"old.html"}) {
+		t.Fatalf("stale file must be released without delete_stale, got removed %v released %v", changes.Removed, changes.Released)
+	}
+
+	changes = diffDirectoryFiles(files, previous, true)
+	if !reflect.DeepEqual(changes.Removed, []string{"old.html"}) || len(changes.Released) != 0 {
+		t.Fatalf("stale file must be removed with delete_stale, got removed %v released %v", changes.Removed, changes.Released)
+	}
+
+	if !diffDirectoryFiles(previous, previous, true).empty() {
+		t.Fatal("expected no changes for the same files")
+	}
+}
+
+func TestMergeEncryptedFiles(t *testing.T) {
+	files := map[string]string{
+		"index.html":   "a",
+		"css/main.css": "b",
+		"js/main.js":   "c",
+	}
+	previous := map[string]string{
+		"index.html":   "2024-01-01T00:00:00Z",
+		"css/main.css": "2024-01-01T00:00:00Z",
+		"old.html":     "2024-01-01T00:00:00Z",
+	}
+	uploaded := map[string]string{
+		"js/main.js": "2024-01-02T00:00:00Z",
+	}
+	changes := directoryChangeSet{
+		Added:   []string{"js/main.js"},
+		Changed: []string{"css/main.css"},
+		Removed: []string{"old.html"},
+	}
+
+	expected := map[string]string{
+		"index.html": "2024-01-01T00:00:00Z",
+		"js/main.js": "2024-01-02T00:00:00Z",
+	}
+
+	if result := mergeEncryptedFiles(previous, uploaded, files, changes); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected encrypted files %v, but got %v", expected, result)
+	}
+}
+
+func TestScanDirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(dir, "docs"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte("abcdefghij"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "docs", "README"), []byte("# readme"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := scanDirectory(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := scanDirectory(filepath.Join(dir, "missing")); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected a missing directory to be reported as not exist, but got %v", err)
+	}
+
+	if len(files) != 2 {
+		t.Fatalf("expected 2 files, but got %v", files)
+	}
+
+	if files["index.html"] != "a925576942e94b2ef57a066101b48876" {
+		t.Fatalf("unexpected MD5 digest of index.html: %s", files["index.html"])
+	}
+
+	if _, ok := files["docs/README"]; !ok {
+		t.Fatalf("expected slash separated relative path, but got %v", files)
+	}
+}
+
+func TestDetectContentType(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "style.css", content: "body {}", expected: "text/css"},
+		{name: "README", content: "plain text", expected: "text/plain"},
+		{name: "page", content: "<html><body></body></html>", expected: "text/html"},
+	}
+
+	for _, c := range cases {
+		result, err := detectContentType(strings.NewReader(c.content), c.name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !strings.HasPrefix(result, c.expected) {
+			t.Fatalf("expected content type of %s to be %s, but got %s", c.name, c.expected, result)
+		}
+	}
+}
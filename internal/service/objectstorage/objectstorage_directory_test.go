@@ -0,0 +1,193 @@
+package objectstorage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+func TestAccResourceNcloudObjectStorage_directory_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_directory.testing_directory"
+	prefix := "static"
+
+	dir := t.TempDir()
+	writeDirectoryFile(t, dir, "index.html", "<html><body>index</body></html>")
+	writeDirectoryFile(t, dir, "css/main.css", "body {}")
+	writeDirectoryFile(t, dir, "old.txt", "stale file")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig(bucketName, prefix, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectExists(resourceName, "css/main.css"),
+					resource.TestCheckResourceAttr(resourceName, "id", bucketName+"/"+prefix),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "encrypted_files.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "changes.added.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "changes.changed.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "changes.removed.#", "0"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeDirectoryFile(t, dir, "index.html", "<html><body>edited</body></html>")
+					writeDirectoryFile(t, dir, "js/main.js", "console.log('added')")
+					if err := os.Remove(filepath.Join(dir, "old.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryConfig(bucketName, prefix, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectExists(resourceName, "js/main.js"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "changes.added.0", "js/main.js"),
+					resource.TestCheckResourceAttr(resourceName, "changes.changed.0", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "changes.removed.0", "old.txt"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccPutDirectoryObject(t, bucketName, prefix+"/css/main.css", "body { color: red; }")
+				},
+				Config: testAccDirectoryConfig(bucketName, prefix, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "changes.added.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "changes.changed.0", "css/main.css"),
+					resource.TestCheckResourceAttr(resourceName, "changes.removed.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_dir", "delete_stale", "changes"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_directory_deleteStaleWithoutPrefix(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectoryWithoutPrefixConfig(bucketName, t.TempDir()),
+				ExpectError: regexp.MustCompile("prefix must be set when delete_stale is true"),
+			},
+		},
+	})
+}
+
+func writeDirectoryFile(t *testing.T, dir, name, content string) {
+	filename := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckDirectoryObjectExists(n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resource, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		if resource.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := TestAccProvider.Meta().(*conn.ProviderConfig)
+		_, err := config.Client.ObjectStorage.HeadObject(context.Background(), &s3.HeadObjectInput{
+			Bucket: ncloud.String(resource.Primary.Attributes["bucket"]),
+			Key:    ncloud.String(resource.Primary.Attributes["prefix"] + "/" + name),
+		})
+
+		return err
+	}
+}
+
+func testAccPutDirectoryObject(t *testing.T, bucketName, key, content string) {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	_, err := config.Client.ObjectStorage.PutObject(context.Background(), &s3.PutObjectInput{
+		Bucket: ncloud.String(bucketName),
+		Key:    ncloud.String(key),
+		Body:   strings.NewReader(content),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckDirectoryDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_objectstorage_directory" {
+			continue
+		}
+
+		resp, err := config.Client.ObjectStorage.ListObjectsV2(context.Background(), &s3.ListObjectsV2Input{
+			Bucket: ncloud.String(rs.Primary.Attributes["bucket"]),
+			Prefix: ncloud.String(rs.Primary.Attributes["prefix"] + "/"),
+		})
+		if err != nil {
+			return nil
+		}
+
+		if len(resp.Contents) > 0 {
+			return fmt.Errorf("Directory objects found")
+		}
+	}
+
+	return nil
+}
+
+func testAccDirectoryConfig(bucketName, prefix, dir string) string {
+	return fmt.Sprintf(`
+resource "ncloud_objectstorage_bucket" "testing_bucket" {
+	bucket_name				= "%[1]s"
+}
+
+resource "ncloud_objectstorage_directory" "testing_directory" {
+	bucket					= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+	prefix					= "%[2]s"
+	source_dir				= "%[3]s"
+	delete_stale			= true
+}`, bucketName, prefix, dir)
+}
+
+func testAccDirectoryWithoutPrefixConfig(bucketName, dir string) string {
+	return fmt.Sprintf(`
+resource "ncloud_objectstorage_directory" "testing_directory" {
+	bucket					= "%[1]s"
+	source_dir				= "%[2]s"
+	delete_stale			= true
+}`, bucketName, dir)
+}
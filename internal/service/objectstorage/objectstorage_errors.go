@@ -0,0 +1,16 @@
+package objectstorage
+
+import (
+	"errors"
+)
+
+// isNoSuchBucket reports whether the bucket of a sub-resource no longer exists, so the resource is removed from state.
+// The error code is compared as a whole, as NoSuchBucketPolicy and the like share its prefix.
+func isNoSuchBucket(err error) bool {
+	var apiErr interface{ ErrorCode() string }
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "NoSuchBucket"
+	}
+
+	return false
+}
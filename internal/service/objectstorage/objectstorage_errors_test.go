@@ -0,0 +1,31 @@
+package objectstorage
+
+import (
+	"fmt"
+	"testing"
+
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+type apiError string
+
+func (e apiError) Error() string     { return "api error " + string(e) }
+func (e apiError) ErrorCode() string { return string(e) }
+
+func TestIsNoSuchBucket(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{err: &awsTypes.NoSuchBucket{}, expected: true},
+		{err: fmt.Errorf("operation error: %w", apiError("NoSuchBucket")), expected: true},
+		{err: apiError("NoSuchBucketPolicy"), expected: false},
+		{err: fmt.Errorf("NoSuchBucket"), expected: false},
+	}
+
+	for _, c := range cases {
+		if result := isNoSuchBucket(c.err); result != c.expected {
+			t.Fatalf("expected %t for %v, but got %t", c.expected, c.err, result)
+		}
+	}
+}
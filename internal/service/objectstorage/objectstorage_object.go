@@ -1,19 +1,27 @@
 package objectstorage
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -26,6 +34,7 @@ var (
 	_ resource.Resource                = &objectResource{}
 	_ resource.ResourceWithConfigure   = &objectResource{}
 	_ resource.ResourceWithImportState = &objectResource{}
+	_ resource.ResourceWithModifyPlan  = &objectResource{}
 )
 
 func NewObjectResource() resource.Resource {
@@ -44,48 +53,30 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	file, err := os.Open(plan.Source.ValueString())
+	body, closeBody, err := plan.body()
 	if err != nil {
-		resp.Diagnostics.AddError("CREATING ERROR", "invalid source path")
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	defer file.Close()
+	defer closeBody()
 
 	reqParams := &s3.PutObjectInput{
 		Bucket: plan.Bucket.ValueStringPointer(),
 		Key:    plan.Key.ValueStringPointer(),
-		Body:   file,
-	}
-
-	if !plan.ContentEncoding.IsNull() && !plan.ContentEncoding.IsUnknown() {
-		reqParams.ContentEncoding = plan.ContentEncoding.ValueStringPointer()
-	}
-
-	if !plan.ContentLanguage.IsNull() && !plan.ContentLanguage.IsUnknown() {
-		reqParams.ContentLanguage = plan.ContentLanguage.ValueStringPointer()
-	}
-
-	if !plan.ContentType.IsNull() && !plan.ContentType.IsUnknown() {
-		reqParams.ContentType = plan.ContentType.ValueStringPointer()
+		Body:   body,
 	}
 
-	if !plan.WebsiteRedirectLocation.IsNull() && !plan.WebsiteRedirectLocation.IsUnknown() {
-		reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
+	plan.headers().putObjectInput(ctx, reqParams, &resp.Diagnostics)
+	reqParams.Tagging = objectTagging(ctx, plan.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	tflog.Info(ctx, "PutObject reqParams="+common.MarshalUncheckedString(reqParams))
-
-	output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
+	versionId, _, err := uploadObject(ctx, o.config, plan.multipartUploadOptions(), reqParams)
 	if err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
 		return
 	}
-	if output == nil {
-		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
-		return
-	}
-
-	tflog.Info(ctx, "PutObject response="+common.MarshalUncheckedString(output))
 
 	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
 		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
@@ -97,6 +88,11 @@ func (o *objectResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// HeadObject may still return the previous version right after the upload when versioning is enabled
+	if versionId != nil {
+		plan.VersionId = types.StringPointerValue(versionId)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -171,12 +167,79 @@ func (o *objectResource) Schema(_ context.Context, req resource.SchemaRequest, r
 				Description: "(Required) Name of the object once it is in the bucket",
 			},
 			"source": schema.StringAttribute{
-				Required:  true,
+				Optional:  true,
 				Sensitive: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("content"),
+						path.MatchRelative().AtParent().AtName("content_base64"),
+					),
+				},
+				Description: "Path of the object",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Literal string value to use as the object content",
+			},
+			"content_base64": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base64-encoded data to use as the object content",
+			},
+			"source_hash": schema.StringAttribute{
+				Optional:    true,
+				Description: "Hash of the source file, any change triggers an update",
+			},
+			"multipart_threshold": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(manager.MinUploadPartSize),
+				},
+				Description: "Size in bytes from which a seekable body is uploaded in parts",
+			},
+			"multipart_part_size": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(manager.MinUploadPartSize),
 				},
-				Description: "(Required) Path of the object",
+				Description: "Size in bytes of each part of a multipart upload",
+			},
+			"multipart_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				Description: "Number of parts uploaded in parallel",
+			},
+			"cache_control": schema.StringAttribute{
+				Optional:    true,
+				Description: "Caching behavior along the request/reply chain",
+			},
+			"content_disposition": schema.StringAttribute{
+				Optional:    true,
+				Description: "Presentational information for the object",
+			},
+			"expires": schema.StringAttribute{
+				Optional:    true,
+				Validators:  objectExpiresValidators(),
+				Description: "Date and time at which the object is no longer cacheable, in RFC3339 format",
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  objectMetadataValidators(),
+				Description: "User metadata stored with x-amz-meta- prefix",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  objectTagsValidators(),
+				Description: "Tags of the object",
+			},
+			"storage_class": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Validators:  objectStorageClassValidators(),
+				Description: "Storage class of the object",
 			},
 			"accept_ranges": schema.StringAttribute{
 				Computed: true,
@@ -209,6 +272,9 @@ func (o *objectResource) Schema(_ context.Context, req resource.SchemaRequest, r
 			"website_redirect_location": schema.StringAttribute{
 				Computed: true,
 			},
+			"server_side_encryption": schema.StringAttribute{
+				Computed: true,
+			},
 			"last_modified": schema.StringAttribute{
 				Computed: true,
 			},
@@ -226,92 +292,117 @@ func (o *objectResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	reqParams := &s3.PutObjectInput{
-		Bucket: state.Bucket.ValueStringPointer(),
-		Key:    state.Key.ValueStringPointer(),
+	bodyChanged := objectBodyChanged(&plan, &state)
+	headersChanged := plan.headers().changed(state.headers())
+	tagsChanged := !plan.Tags.Equal(state.Tags)
+
+	// multipart settings only affect how the content is uploaded, there is nothing to apply on their own
+	if !bodyChanged && !headersChanged && !tagsChanged {
+		state.MultipartThreshold = plan.MultipartThreshold
+		state.MultipartPartSize = plan.MultipartPartSize
+		state.MultipartConcurrency = plan.MultipartConcurrency
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
 	}
 
-	// get body from plan with source path or existing object
-	if !plan.Source.Equal(state.Source) {
-		file, err := os.Open(plan.Source.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", "invalid source path")
-			return
-		}
-		defer file.Close()
+	var versionId *string
 
-		reqParams.Body = file
-	} else {
-		// Prevent wasting of GetObject operation
-		if !plan.ContentType.Equal(state.ContentType) && o.config.RegionCode == "JPN" {
-			resp.Diagnostics.AddError("UPDATING ERROR", "updating object Content-Type is unavailable in this region")
+	switch {
+	case bodyChanged:
+		body, closeBody, err := plan.body()
+		if err != nil {
+			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
 			return
 		}
+		defer closeBody()
 
-		getReqParams := &s3.GetObjectInput{
+		reqParams := &s3.PutObjectInput{
 			Bucket: state.Bucket.ValueStringPointer(),
 			Key:    state.Key.ValueStringPointer(),
+			Body:   body,
 		}
 
-		tflog.Info(ctx, "GetObject at update operation reqParams="+common.MarshalUncheckedString(getReqParams))
+		plan.headers().putObjectInput(ctx, reqParams, &resp.Diagnostics)
+		reqParams.Tagging = objectTagging(ctx, plan.Tags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-		getOutput, err := o.config.Client.ObjectStorage.GetObject(ctx, getReqParams)
+		versionId, _, err = uploadObject(ctx, o.config, plan.multipartUploadOptions(), reqParams)
 		if err != nil {
 			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
 			return
 		}
-		if getOutput == nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", "response invalid at get object")
+	case headersChanged:
+		if isPlanned(plan.ContentType, state.ContentType) && o.config.RegionCode == "JPN" {
+			resp.Diagnostics.AddError("UPDATING ERROR", "updating object Content-Type is unavailable in this region")
 			return
 		}
 
-		tflog.Info(ctx, "GetObject at update operation response="+common.MarshalUncheckedString(getOutput))
-
-		reqParams.Body = getOutput.Body
+		// metadata and headers are replaced by copying the object onto itself, without uploading the content again
+		versionId = copyObjectInPlace(ctx, o.config, state.Bucket.ValueString(), state.Key.ValueString(), plan.headers().withState(state.headers()), plan.Tags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	default:
+		putObjectTags(ctx, o.config, state.Bucket.ValueString(), state.Key.ValueString(), plan.Tags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	// attributes that has dependancies with source
-	if !plan.ContentEncoding.IsNull() && !plan.ContentEncoding.IsUnknown() {
-		reqParams.ContentEncoding = plan.ContentEncoding.ValueStringPointer()
+	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
 	}
 
-	if !plan.ContentLanguage.IsNull() && !plan.ContentLanguage.IsUnknown() {
-		reqParams.ContentLanguage = plan.ContentLanguage.ValueStringPointer()
+	plan.refreshFromOutput(ctx, o.config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !plan.WebsiteRedirectLocation.IsNull() && !plan.WebsiteRedirectLocation.IsUnknown() {
-		reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
+	// HeadObject may still return the previous version right after the upload when versioning is enabled
+	if versionId != nil {
+		plan.VersionId = types.StringPointerValue(versionId)
 	}
 
-	if !plan.ContentType.Equal(state.ContentType) && !plan.ContentType.IsNull() && !plan.ContentType.IsUnknown() {
-		reqParams.ContentType = plan.ContentType.ValueStringPointer()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (o *objectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
 	}
 
-	tflog.Info(ctx, "PutObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
+	var plan, state objectResourceModel
 
-	output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
-	if err != nil {
-		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if output == nil {
-		resp.Diagnostics.AddError("UPDATING ERROR", "response invalid at put object")
+
+	if !objectBodyChanged(&plan, &state) {
 		return
 	}
 
-	tflog.Info(ctx, "PutObject at update operation response="+common.MarshalUncheckedString(output))
-
-	if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
-		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
-		return
+	// Every attribute read back from the uploaded object may change along with the content
+	for _, name := range []string{"accept_ranges", "content_encoding", "content_language", "etag", "expiration", "last_modified", "version_id", "website_redirect_location", "server_side_encryption"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
 	}
 
-	plan.refreshFromOutput(ctx, o.config, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
+	for _, name := range []string{"content_length", "parts_count"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.Int64Unknown())...)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	var contentType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content_type"), &contentType)...)
+	if contentType.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_type"), types.StringUnknown())...)
+	}
 }
 
 func (o *objectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -412,6 +503,18 @@ type objectResourceModel struct {
 	Bucket                  types.String `tfsdk:"bucket"`
 	Key                     types.String `tfsdk:"key"`
 	Source                  types.String `tfsdk:"source"`
+	Content                 types.String `tfsdk:"content"`
+	ContentBase64           types.String `tfsdk:"content_base64"`
+	SourceHash              types.String `tfsdk:"source_hash"`
+	MultipartThreshold      types.Int64  `tfsdk:"multipart_threshold"`
+	MultipartPartSize       types.Int64  `tfsdk:"multipart_part_size"`
+	MultipartConcurrency    types.Int64  `tfsdk:"multipart_concurrency"`
+	CacheControl            types.String `tfsdk:"cache_control"`
+	ContentDisposition      types.String `tfsdk:"content_disposition"`
+	Expires                 types.String `tfsdk:"expires"`
+	Metadata                types.Map    `tfsdk:"metadata"`
+	Tags                    types.Map    `tfsdk:"tags"`
+	StorageClass            types.String `tfsdk:"storage_class"`
 	AcceptRanges            types.String `tfsdk:"accept_ranges"`
 	ContentEncoding         types.String `tfsdk:"content_encoding"`
 	ContentLanguage         types.String `tfsdk:"content_language"`
@@ -423,9 +526,13 @@ type objectResourceModel struct {
 	PartsCount              types.Int64  `tfsdk:"parts_count"`
 	VersionId               types.String `tfsdk:"version_id"`
 	WebsiteRedirectLocation types.String `tfsdk:"website_redirect_location"`
+	ServerSideEncryption    types.String `tfsdk:"server_side_encryption"`
 }
 
 func (o *objectResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, diag *diag.Diagnostics) {
+	// ID is only missing right after import
+	imported := o.ID.IsNull()
+
 	output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
 		Bucket: o.Bucket.ValueStringPointer(),
 		Key:    o.Key.ValueStringPointer(),
@@ -480,9 +587,130 @@ func (o *objectResourceModel) refreshFromOutput(ctx context.Context, config *con
 		o.WebsiteRedirectLocation = types.StringPointerValue(output.WebsiteRedirectLocation)
 	}
 
+	o.ServerSideEncryption = stringValueOrNull(string(output.ServerSideEncryption))
+
 	if output.LastModified != nil {
 		o.LastModified = types.StringValue(output.LastModified.Format(time.RFC3339))
 	}
+
+	headers := o.headers()
+	headers.refreshFromOutput(ctx, output, diag)
+	o.setHeaders(headers)
+
+	// Tags are read with a separate request, only when they are managed or imported
+	if !o.Tags.IsNull() || imported {
+		o.Tags = readObjectTags(ctx, config, o.Bucket.ValueString(), o.Key.ValueString(), diag)
+	}
+}
+
+func (o *objectResourceModel) headers() objectHeaders {
+	return objectHeaders{
+		CacheControl:            o.CacheControl,
+		ContentDisposition:      o.ContentDisposition,
+		ContentEncoding:         o.ContentEncoding,
+		ContentLanguage:         o.ContentLanguage,
+		ContentType:             o.ContentType,
+		Expires:                 o.Expires,
+		Metadata:                o.Metadata,
+		StorageClass:            o.StorageClass,
+		WebsiteRedirectLocation: o.WebsiteRedirectLocation,
+	}
+}
+
+func (o *objectResourceModel) setHeaders(headers objectHeaders) {
+	o.CacheControl = headers.CacheControl
+	o.ContentDisposition = headers.ContentDisposition
+	o.Expires = headers.Expires
+	o.Metadata = headers.Metadata
+	o.StorageClass = headers.StorageClass
+}
+
+// body returns the upload body from one of source, content or content_base64.
+func (o *objectResourceModel) body() (io.Reader, func(), error) {
+	switch {
+	case !o.Content.IsNull():
+		return strings.NewReader(o.Content.ValueString()), func() {}, nil
+	case !o.ContentBase64.IsNull():
+		decoded, err := base64.StdEncoding.DecodeString(o.ContentBase64.ValueString())
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid content_base64: %s", err)
+		}
+		return bytes.NewReader(decoded), func() {}, nil
+	default:
+		file, err := os.Open(o.Source.ValueString())
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid source path")
+		}
+		return file, func() { file.Close() }, nil
+	}
+}
+
+func (o *objectResourceModel) multipartUploadOptions() multipartUploadOptions {
+	return multipartUploadOptions{
+		Threshold:   o.MultipartThreshold,
+		PartSize:    o.MultipartPartSize,
+		Concurrency: o.MultipartConcurrency,
+	}
+}
+
+// objectBodyChanged reports whether the object content must be uploaded again.
+// Besides changes in configuration, edits to the local source file are detected by its size and modification time,
+// and only a file modified after the upload is compared by its MD5 digests with the ETag.
+func objectBodyChanged(plan, state *objectResourceModel) bool {
+	if !plan.Source.Equal(state.Source) || !plan.Content.Equal(state.Content) ||
+		!plan.ContentBase64.Equal(state.ContentBase64) || !plan.SourceHash.Equal(state.SourceHash) {
+		return true
+	}
+
+	// a hash supplied by the user takes the place of inspecting the file
+	if plan.Source.IsNull() || plan.Source.IsUnknown() || isKnown(plan.SourceHash) {
+		return false
+	}
+
+	info, err := os.Stat(plan.Source.ValueString())
+	if err != nil || !info.Mode().IsRegular() {
+		// a missing source is reported when uploading
+		return false
+	}
+	size := info.Size()
+
+	if !state.ContentLength.IsNull() && !state.ContentLength.IsUnknown() && size != state.ContentLength.ValueInt64() {
+		return true
+	}
+
+	uploaded, err := time.Parse(time.RFC3339, state.LastModified.ValueString())
+	hasUploadTime := err == nil
+	if hasUploadTime && info.ModTime().Before(uploaded) {
+		return false
+	}
+
+	// ETag of a KMS encrypted object is not derived from MD5 digests, a file modified after the upload is sent again
+	etag := strings.Trim(state.ETag.ValueString(), `"`)
+	if state.ServerSideEncryption.ValueString() == string(awsTypes.ServerSideEncryptionAwsKms) {
+		return hasUploadTime
+	}
+	if etag == "" {
+		return false
+	}
+
+	file, err := os.Open(plan.Source.ValueString())
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	// objects uploaded in parts have an ETag suffixed with the number of parts
+	partSize := size
+	if strings.Contains(etag, "-") {
+		partSize = multipartPartSize(state.MultipartPartSize, size)
+	}
+
+	expected, err := multipartETag(file, size, partSize)
+	if err != nil {
+		return false
+	}
+
+	return expected != etag
 }
 
 func ObjectIDGenerator(bucketName, key string) string {
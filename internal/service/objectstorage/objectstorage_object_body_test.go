@@ -0,0 +1,55 @@
+package objectstorage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestObjectBodyChanged(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "object.txt")
+	if err := os.WriteFile(source, []byte("abcdefghij"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	modified := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(source, modified, modified); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name         string
+		length       int64
+		lastModified time.Time
+		etag         string
+		sourceHash   types.String
+		expected     bool
+	}{
+		{name: "uploaded after the edit", length: 10, lastModified: modified.Add(time.Minute), etag: "mismatch", sourceHash: types.StringNull(), expected: false},
+		{name: "size changed", length: 5, lastModified: modified.Add(time.Minute), etag: "mismatch", sourceHash: types.StringNull(), expected: true},
+		{name: "edited with the same content", length: 10, lastModified: modified.Add(-time.Minute), etag: `"a925576942e94b2ef57a066101b48876"`, sourceHash: types.StringNull(), expected: false},
+		{name: "edited with another content", length: 10, lastModified: modified.Add(-time.Minute), etag: `"mismatch"`, sourceHash: types.StringNull(), expected: true},
+		{name: "source hash supplied", length: 5, lastModified: modified.Add(-time.Minute), etag: `"mismatch"`, sourceHash: types.StringValue("hash"), expected: false},
+	}
+
+	for _, c := range cases {
+		plan := &objectResourceModel{
+			Source:        types.StringValue(source),
+			Content:       types.StringNull(),
+			ContentBase64: types.StringNull(),
+			SourceHash:    c.sourceHash,
+		}
+		state := *plan
+		state.ContentLength = types.Int64Value(c.length)
+		state.LastModified = types.StringValue(c.lastModified.UTC().Format(time.RFC3339))
+		state.ETag = types.StringValue(c.etag)
+		state.MultipartPartSize = types.Int64Null()
+
+		if result := objectBodyChanged(plan, &state); result != c.expected {
+			t.Fatalf("%s: expected %t, but got %t", c.name, c.expected, result)
+		}
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -60,26 +62,9 @@ func (o *objectCopyResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	reqParams := &s3.CopyObjectInput{
-		Bucket:     plan.Bucket.ValueStringPointer(),
-		CopySource: plan.Source.ValueStringPointer(),
-		Key:        plan.Key.ValueStringPointer(),
-	}
-
-	if !plan.ContentEncoding.IsNull() && !plan.ContentEncoding.IsUnknown() {
-		reqParams.ContentEncoding = plan.ContentEncoding.ValueStringPointer()
-	}
-
-	if !plan.ContentLanguage.IsNull() && !plan.ContentLanguage.IsUnknown() {
-		reqParams.ContentLanguage = plan.ContentLanguage.ValueStringPointer()
-	}
-
-	if !plan.ContentType.IsNull() && !plan.ContentType.IsUnknown() {
-		reqParams.ContentType = plan.ContentType.ValueStringPointer()
-	}
-
-	if !plan.WebsiteRedirectLocation.IsNull() && !plan.WebsiteRedirectLocation.IsUnknown() {
-		reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
+	reqParams := plan.copyObjectInput(ctx, o.config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	tflog.Info(ctx, "CopyObject reqParams="+common.MarshalUncheckedString(reqParams))
@@ -198,6 +183,37 @@ func (o *objectCopyResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Required:    true,
 				Description: "(Required) Path of the object",
 			},
+			"cache_control": schema.StringAttribute{
+				Optional:    true,
+				Description: "Caching behavior along the request/reply chain",
+			},
+			"content_disposition": schema.StringAttribute{
+				Optional:    true,
+				Description: "Presentational information for the object",
+			},
+			"expires": schema.StringAttribute{
+				Optional:    true,
+				Validators:  objectExpiresValidators(),
+				Description: "Date and time at which the object is no longer cacheable, in RFC3339 format",
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  objectMetadataValidators(),
+				Description: "User metadata stored with x-amz-meta- prefix",
+			},
+			"tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  objectTagsValidators(),
+				Description: "Tags of the object",
+			},
+			"storage_class": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Validators:  objectStorageClassValidators(),
+				Description: "Storage class of the object",
+			},
 			"accept_ranges": schema.StringAttribute{
 				Computed: true,
 			},
@@ -246,28 +262,11 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	if !plan.Source.Equal(state.Source) {
-		reqParams := &s3.CopyObjectInput{
-			Bucket:     state.Bucket.ValueStringPointer(),
-			CopySource: plan.Source.ValueStringPointer(),
-			Key:        state.Key.ValueStringPointer(),
-		}
-
-		// attributes that has dependancies with source
-		if !plan.ContentEncoding.IsNull() && !plan.ContentEncoding.IsUnknown() {
-			reqParams.ContentEncoding = plan.ContentEncoding.ValueStringPointer()
-		}
-
-		if !plan.ContentLanguage.IsNull() && !plan.ContentLanguage.IsUnknown() {
-			reqParams.ContentLanguage = plan.ContentLanguage.ValueStringPointer()
-		}
-
-		if !plan.ContentType.IsNull() && !plan.ContentType.IsUnknown() {
-			reqParams.ContentType = plan.ContentType.ValueStringPointer()
-		}
-
-		if !plan.WebsiteRedirectLocation.IsNull() && !plan.WebsiteRedirectLocation.IsUnknown() {
-			reqParams.WebsiteRedirectLocation = plan.WebsiteRedirectLocation.ValueStringPointer()
+	switch {
+	case !plan.Source.Equal(state.Source):
+		reqParams := plan.copyObjectInput(ctx, o.config, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
 		tflog.Info(ctx, "CopyObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
@@ -283,78 +282,35 @@ func (o *objectCopyResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 
 		tflog.Info(ctx, "CopyObject at update operation response="+common.MarshalUncheckedString(output))
-
-		if err := waitObjectCopied(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
-			return
-		}
-
-		plan.refreshFromOutput(ctx, o.config, &resp.Diagnostics)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	}
-
-	if !plan.ContentType.Equal(state.ContentType) {
-
-		if o.config.RegionCode == "JPN" {
+	case plan.headers().changed(state.headers()):
+		if isPlanned(plan.ContentType, state.ContentType) && o.config.RegionCode == "JPN" {
 			resp.Diagnostics.AddError("UPDATING ERROR", "updating object Content-Type is unavailable in this region")
 			return
 		}
 
-		getReqParams := &s3.GetObjectInput{
-			Bucket: state.Bucket.ValueStringPointer(),
-			Key:    state.Key.ValueStringPointer(),
-		}
-
-		tflog.Info(ctx, "GetObject at update operation reqParams="+common.MarshalUncheckedString(getReqParams))
-
-		getOutput, err := o.config.Client.ObjectStorage.GetObject(ctx, getReqParams)
-		if err != nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
-			return
-		}
-		if getOutput == nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", "response invalid at get object")
-			return
-		}
-
-		tflog.Info(ctx, "GetObject at update operation response="+common.MarshalUncheckedString(getOutput))
-
-		reqParams := &s3.PutObjectInput{
-			Bucket: plan.Bucket.ValueStringPointer(),
-			Key:    plan.Key.ValueStringPointer(),
-			Body:   getOutput.Body,
-			// this option is unavailable in JPN region
-			ContentType: plan.ContentType.ValueStringPointer(),
-		}
-
-		tflog.Info(ctx, "PutObject at update operation reqParams="+common.MarshalUncheckedString(reqParams))
-
-		output, err := o.config.Client.ObjectStorage.PutObject(ctx, reqParams)
-		if err != nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
-			return
-		}
-		if output == nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", "response invalid")
-			return
-		}
-
-		tflog.Info(ctx, "PutObject at update operation response="+common.MarshalUncheckedString(output))
-
-		if err := waitObjectUploaded(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
-			resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		// metadata and headers are replaced by copying the object onto itself, without copying the source again
+		copyObjectInPlace(ctx, o.config, state.Bucket.ValueString(), state.Key.ValueString(), plan.headers().withState(state.headers()), plan.Tags, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		plan.refreshFromOutput(ctx, o.config, &resp.Diagnostics)
+	case !plan.Tags.Equal(state.Tags):
+		putObjectTags(ctx, o.config, state.Bucket.ValueString(), state.Key.ValueString(), plan.Tags, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+	}
 
-		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if err := waitObjectCopied(ctx, o.config, plan.Bucket.ValueString(), plan.Key.ValueString()); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
 	}
+
+	plan.refreshFromOutput(ctx, o.config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func waitObjectCopied(ctx context.Context, config *conn.ProviderConfig, bucketName string, key string) error {
@@ -422,6 +378,12 @@ type objectCopyResourceModel struct {
 	Bucket                  types.String `tfsdk:"bucket"`
 	Key                     types.String `tfsdk:"key"`
 	Source                  types.String `tfsdk:"source"`
+	CacheControl            types.String `tfsdk:"cache_control"`
+	ContentDisposition      types.String `tfsdk:"content_disposition"`
+	Expires                 types.String `tfsdk:"expires"`
+	Metadata                types.Map    `tfsdk:"metadata"`
+	Tags                    types.Map    `tfsdk:"tags"`
+	StorageClass            types.String `tfsdk:"storage_class"`
 	AcceptRanges            types.String `tfsdk:"accept_ranges"`
 	ContentEncoding         types.String `tfsdk:"content_encoding"`
 	ContentLanguage         types.String `tfsdk:"content_language"`
@@ -436,6 +398,9 @@ type objectCopyResourceModel struct {
 }
 
 func (o *objectCopyResourceModel) refreshFromOutput(ctx context.Context, config *conn.ProviderConfig, diag *diag.Diagnostics) {
+	// ID is only missing right after import
+	imported := o.ID.IsNull()
+
 	output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
 		Bucket: o.Bucket.ValueStringPointer(),
 		Key:    o.Key.ValueStringPointer(),
@@ -493,4 +458,88 @@ func (o *objectCopyResourceModel) refreshFromOutput(ctx context.Context, config
 	if output.LastModified != nil {
 		o.LastModified = types.StringValue(output.LastModified.Format(time.RFC3339))
 	}
+
+	headers := o.headers()
+	headers.refreshFromOutput(ctx, output, diag)
+	o.setHeaders(headers)
+
+	// Tags are read with a separate request, only when they are managed or imported
+	if !o.Tags.IsNull() || imported {
+		o.Tags = readObjectTags(ctx, config, o.Bucket.ValueString(), o.Key.ValueString(), diag)
+	}
+}
+
+// copyObjectInput returns the request copying the source. Metadata and tags of the source are always replaced
+// by the configured ones, so the copy matches the configuration. Content headers left unset are kept from the source.
+func (o *objectCopyResourceModel) copyObjectInput(ctx context.Context, config *conn.ProviderConfig, diag *diag.Diagnostics) *s3.CopyObjectInput {
+	reqParams := &s3.CopyObjectInput{
+		Bucket:            o.Bucket.ValueStringPointer(),
+		CopySource:        o.Source.ValueStringPointer(),
+		Key:               o.Key.ValueStringPointer(),
+		MetadataDirective: awsTypes.MetadataDirectiveReplace,
+		TaggingDirective:  awsTypes.TaggingDirectiveReplace,
+		Tagging:           objectTagging(ctx, o.Tags, diag),
+	}
+
+	headers := o.headers()
+	headers.copyObjectInput(ctx, reqParams, diag)
+	if diag.HasError() {
+		return nil
+	}
+
+	sourceBucket, sourceKey := ObjectIDParser(o.Source.ValueString())
+	if sourceBucket == "" || sourceKey == "" {
+		diag.AddError("CopyObject ERROR", fmt.Sprintf("expected source with format: bucket-name/key Got: %q", o.Source.ValueString()))
+		return nil
+	}
+
+	output, err := config.Client.ObjectStorage.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: ncloud.String(sourceBucket),
+		Key:    ncloud.String(sourceKey),
+	})
+	if err != nil {
+		diag.AddError("HeadObject ERROR", err.Error())
+		return nil
+	}
+	if output == nil {
+		diag.AddError("HeadObject ERROR", "invalid output")
+		return nil
+	}
+
+	if reqParams.ContentEncoding == nil {
+		reqParams.ContentEncoding = output.ContentEncoding
+	}
+	if reqParams.ContentLanguage == nil {
+		reqParams.ContentLanguage = output.ContentLanguage
+	}
+	if reqParams.ContentType == nil {
+		reqParams.ContentType = output.ContentType
+	}
+	if reqParams.WebsiteRedirectLocation == nil {
+		reqParams.WebsiteRedirectLocation = output.WebsiteRedirectLocation
+	}
+
+	return reqParams
+}
+
+func (o *objectCopyResourceModel) headers() objectHeaders {
+	return objectHeaders{
+		CacheControl:            o.CacheControl,
+		ContentDisposition:      o.ContentDisposition,
+		ContentEncoding:         o.ContentEncoding,
+		ContentLanguage:         o.ContentLanguage,
+		ContentType:             o.ContentType,
+		Expires:                 o.Expires,
+		Metadata:                o.Metadata,
+		StorageClass:            o.StorageClass,
+		WebsiteRedirectLocation: o.WebsiteRedirectLocation,
+	}
+}
+
+func (o *objectCopyResourceModel) setHeaders(headers objectHeaders) {
+	o.CacheControl = headers.CacheControl
+	o.ContentDisposition = headers.ContentDisposition
+	o.Expires = headers.Expires
+	o.Metadata = headers.Metadata
+	o.StorageClass = headers.StorageClass
 }
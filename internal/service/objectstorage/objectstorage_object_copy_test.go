@@ -148,6 +148,112 @@ func TestAccResourceNcloudObjectStorage_object_copy_update_content_type(t *testi
 	})
 }
 
+func TestAccResourceNcloudObjectStorage_object_copy_metadata(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_object_copy.testing_copy"
+	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	content := "content for file upload testing"
+	key := "test/key/" + sourceName
+
+	tmpFile := CreateTempFile(t, content, sourceName)
+	source := tmpFile.Name()
+	defer os.Remove(source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyMetadataConfig(bucketName, key, source, "first", "dev"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "metadata.owner", "first"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "dev"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "no-cache"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
+				),
+			},
+			{
+				Config: testAccObjectCopyMetadataConfig(bucketName, key, source, "second", "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "metadata.owner", "second"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "no-cache"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_object_copy_sourceMetadata(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_object_copy.testing_copy"
+	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	content := "content for file upload testing"
+	key := "test/key/" + sourceName
+
+	tmpFile := CreateTempFile(t, content, sourceName)
+	source := tmpFile.Name()
+	defer os.Remove(source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopySourceMetadataConfig(bucketName, key, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName, TestAccProvider),
+					testAccCheckObjectCopyWithoutMetadata(resourceName, TestAccProvider),
+					resource.TestCheckNoResourceAttr(resourceName, "metadata.%"),
+					resource.TestCheckNoResourceAttr(resourceName, "tags.%"),
+					resource.TestCheckNoResourceAttr(resourceName, "cache_control"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/markdown"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectCopyWithoutMetadata(n string, provider *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resource, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found %s", n)
+		}
+
+		config := provider.Meta().(*conn.ProviderConfig)
+		head, err := config.Client.ObjectStorage.HeadObject(context.Background(), &s3.HeadObjectInput{
+			Bucket: ncloud.String(resource.Primary.Attributes["bucket"]),
+			Key:    ncloud.String(resource.Primary.Attributes["key"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(head.Metadata) > 0 {
+			return fmt.Errorf("metadata of the source object copied: %v", head.Metadata)
+		}
+
+		tagging, err := config.Client.ObjectStorage.GetObjectTagging(context.Background(), &s3.GetObjectTaggingInput{
+			Bucket: ncloud.String(resource.Primary.Attributes["bucket"]),
+			Key:    ncloud.String(resource.Primary.Attributes["key"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(tagging.TagSet) > 0 {
+			return fmt.Errorf("tags of the source object copied: %d tags", len(tagging.TagSet))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckObjectCopyExists(n string, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resource, ok := s.RootModule().Resources[n]
@@ -308,3 +414,70 @@ func testAccObjectCopySourcePostUpdateConfig(bucketName, key, source, postObject
 	}	
 	`, bucketName, key, source, postObjectKey)
 }
+
+func testAccObjectCopyMetadataConfig(bucketName, key, source, owner, env string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket_from" {
+		bucket_name			= "%[1]s-from"
+	}
+
+	resource "ncloud_objectstorage_bucket" "testing_bucket_to" {
+		bucket_name			= "%[1]s-to"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket.testing_bucket_from.bucket_name
+		key 				= "%[2]s"
+		source				= "%[3]s"
+	}
+
+	resource "ncloud_objectstorage_object_copy" "testing_copy" {
+		bucket 				= ncloud_objectstorage_bucket.testing_bucket_to.bucket_name
+		key 				= "%[2]s"
+		source 				= ncloud_objectstorage_object.testing_object.id
+		cache_control		= "no-cache"
+
+		metadata = {
+			owner = "%[4]s"
+		}
+
+		tags = {
+			env = "%[5]s"
+		}
+	}
+	`, bucketName, key, source, owner, env)
+}
+
+func testAccObjectCopySourceMetadataConfig(bucketName, key, source string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket_from" {
+		bucket_name			= "%[1]s-from"
+	}
+
+	resource "ncloud_objectstorage_bucket" "testing_bucket_to" {
+		bucket_name			= "%[1]s-to"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket.testing_bucket_from.bucket_name
+		key 				= "%[2]s"
+		source				= "%[3]s"
+		content_type		= "text/markdown"
+		cache_control		= "no-cache"
+
+		metadata = {
+			owner = "source"
+		}
+
+		tags = {
+			env = "source"
+		}
+	}
+
+	resource "ncloud_objectstorage_object_copy" "testing_copy" {
+		bucket 				= ncloud_objectstorage_bucket.testing_bucket_to.bucket_name
+		key 				= "%[2]s"
+		source 				= ncloud_objectstorage_object.testing_object.id
+	}
+	`, bucketName, key, source)
+}
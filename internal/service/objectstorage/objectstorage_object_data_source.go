@@ -114,6 +114,8 @@ func (o *objectDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.WebsiteRedirectLocation = types.StringPointerValue(output.WebsiteRedirectLocation)
 	}
 
+	data.ServerSideEncryption = stringValueOrNull(string(output.ServerSideEncryption))
+
 	if output.LastModified != nil {
 		data.LastModified = types.StringValue(output.LastModified.Format(time.RFC3339))
 	}
@@ -180,6 +182,9 @@ func (o *objectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"website_redirect_location": schema.StringAttribute{
 				Computed: true,
 			},
+			"server_side_encryption": schema.StringAttribute{
+				Computed: true,
+			},
 			"last_modified": schema.StringAttribute{
 				Computed: true,
 			},
@@ -207,5 +212,6 @@ type objectDataResourceModel struct {
 	PartsCount              types.Int64  `tfsdk:"parts_count"`
 	VersionId               types.String `tfsdk:"version_id"`
 	WebsiteRedirectLocation types.String `tfsdk:"website_redirect_location"`
+	ServerSideEncryption    types.String `tfsdk:"server_side_encryption"`
 	Body                    types.String `tfsdk:"body"`
 }
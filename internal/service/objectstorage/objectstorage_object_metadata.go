@@ -0,0 +1,343 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+// objectHeaders holds the user metadata and headers stored along with an object.
+// Any change to them without a new body is applied by copying the object in place.
+type objectHeaders struct {
+	CacheControl            types.String
+	ContentDisposition      types.String
+	ContentEncoding         types.String
+	ContentLanguage         types.String
+	ContentType             types.String
+	Expires                 types.String
+	Metadata                types.Map
+	StorageClass            types.String
+	WebsiteRedirectLocation types.String
+}
+
+func (h objectHeaders) changed(state objectHeaders) bool {
+	return isPlanned(h.CacheControl, state.CacheControl) ||
+		isPlanned(h.ContentDisposition, state.ContentDisposition) ||
+		isPlanned(h.ContentEncoding, state.ContentEncoding) ||
+		isPlanned(h.ContentLanguage, state.ContentLanguage) ||
+		isPlanned(h.ContentType, state.ContentType) ||
+		isPlanned(h.Expires, state.Expires) ||
+		isPlanned(h.StorageClass, state.StorageClass) ||
+		isPlanned(h.WebsiteRedirectLocation, state.WebsiteRedirectLocation) ||
+		!h.Metadata.Equal(state.Metadata)
+}
+
+// withState keeps the current value of computed headers left unknown in plan,
+// as a copy in place drops every header that is not sent again.
+func (h objectHeaders) withState(state objectHeaders) objectHeaders {
+	for _, v := range []struct{ plan, state *types.String }{
+		{&h.ContentEncoding, &state.ContentEncoding},
+		{&h.ContentLanguage, &state.ContentLanguage},
+		{&h.ContentType, &state.ContentType},
+		{&h.StorageClass, &state.StorageClass},
+		{&h.WebsiteRedirectLocation, &state.WebsiteRedirectLocation},
+	} {
+		if v.plan.IsUnknown() {
+			*v.plan = *v.state
+		}
+	}
+
+	return h
+}
+
+func (h objectHeaders) putObjectInput(ctx context.Context, reqParams *s3.PutObjectInput, diag *diag.Diagnostics) {
+	reqParams.CacheControl = knownStringPointer(h.CacheControl)
+	reqParams.ContentDisposition = knownStringPointer(h.ContentDisposition)
+	reqParams.ContentEncoding = knownStringPointer(h.ContentEncoding)
+	reqParams.ContentLanguage = knownStringPointer(h.ContentLanguage)
+	reqParams.ContentType = knownStringPointer(h.ContentType)
+	reqParams.Expires = expiresTime(h.Expires)
+	reqParams.Metadata = stringMap(ctx, h.Metadata, diag)
+	reqParams.WebsiteRedirectLocation = knownStringPointer(h.WebsiteRedirectLocation)
+
+	if isKnown(h.StorageClass) {
+		reqParams.StorageClass = awsTypes.StorageClass(h.StorageClass.ValueString())
+	}
+}
+
+func (h objectHeaders) copyObjectInput(ctx context.Context, reqParams *s3.CopyObjectInput, diag *diag.Diagnostics) {
+	reqParams.CacheControl = knownStringPointer(h.CacheControl)
+	reqParams.ContentDisposition = knownStringPointer(h.ContentDisposition)
+	reqParams.ContentEncoding = knownStringPointer(h.ContentEncoding)
+	reqParams.ContentLanguage = knownStringPointer(h.ContentLanguage)
+	reqParams.ContentType = knownStringPointer(h.ContentType)
+	reqParams.Expires = expiresTime(h.Expires)
+	reqParams.Metadata = stringMap(ctx, h.Metadata, diag)
+	reqParams.WebsiteRedirectLocation = knownStringPointer(h.WebsiteRedirectLocation)
+
+	if isKnown(h.StorageClass) {
+		reqParams.StorageClass = awsTypes.StorageClass(h.StorageClass.ValueString())
+	}
+}
+
+// refreshFromOutput reads the headers other than the content headers, which the resources already read.
+func (h *objectHeaders) refreshFromOutput(ctx context.Context, output *s3.HeadObjectOutput, diag *diag.Diagnostics) {
+	h.CacheControl = stringValueOrNull(common.StringOrEmpty(output.CacheControl))
+	h.ContentDisposition = stringValueOrNull(common.StringOrEmpty(output.ContentDisposition))
+
+	h.Expires = types.StringNull()
+	if output.Expires != nil {
+		h.Expires = types.StringValue(output.Expires.UTC().Format(time.RFC3339))
+	}
+
+	h.Metadata = types.MapNull(types.StringType)
+	if len(output.Metadata) > 0 {
+		metadata, diags := types.MapValueFrom(ctx, types.StringType, output.Metadata)
+		diag.Append(diags...)
+		h.Metadata = metadata
+	}
+
+	// STANDARD is omitted from the response
+	h.StorageClass = types.StringValue(string(awsTypes.StorageClassStandard))
+	if output.StorageClass != "" {
+		h.StorageClass = types.StringValue(string(output.StorageClass))
+	}
+}
+
+// copyObjectInPlace replaces the metadata and headers of an object by copying it onto itself.
+func copyObjectInPlace(ctx context.Context, config *conn.ProviderConfig, bucketName, key string, headers objectHeaders, tags types.Map, diag *diag.Diagnostics) *string {
+	reqParams := &s3.CopyObjectInput{
+		Bucket:            &bucketName,
+		Key:               &key,
+		CopySource:        copySource(bucketName, key),
+		MetadataDirective: awsTypes.MetadataDirectiveReplace,
+		TaggingDirective:  awsTypes.TaggingDirectiveReplace,
+		Tagging:           objectTagging(ctx, tags, diag),
+	}
+	headers.copyObjectInput(ctx, reqParams, diag)
+	if diag.HasError() {
+		return nil
+	}
+
+	tflog.Info(ctx, "CopyObject in place reqParams="+common.MarshalUncheckedString(reqParams))
+
+	output, err := config.Client.ObjectStorage.CopyObject(ctx, reqParams)
+	if err != nil {
+		diag.AddError("CopyObject ERROR", err.Error())
+		return nil
+	}
+	if output == nil {
+		diag.AddError("CopyObject ERROR", "response invalid")
+		return nil
+	}
+
+	tflog.Info(ctx, "CopyObject in place response="+common.MarshalUncheckedString(output))
+
+	return output.VersionId
+}
+
+// putObjectTags replaces the tag set of an object, removing it when tags are empty.
+func putObjectTags(ctx context.Context, config *conn.ProviderConfig, bucketName, key string, tags types.Map, diag *diag.Diagnostics) {
+	tagMap := stringMap(ctx, tags, diag)
+	if diag.HasError() {
+		return
+	}
+
+	if len(tagMap) == 0 {
+		reqParams := &s3.DeleteObjectTaggingInput{
+			Bucket: &bucketName,
+			Key:    &key,
+		}
+
+		tflog.Info(ctx, "DeleteObjectTagging reqParams="+common.MarshalUncheckedString(reqParams))
+
+		output, err := config.Client.ObjectStorage.DeleteObjectTagging(ctx, reqParams)
+		if err != nil {
+			diag.AddError("DeleteObjectTagging ERROR", err.Error())
+			return
+		}
+
+		tflog.Info(ctx, "DeleteObjectTagging response="+common.MarshalUncheckedString(output))
+		return
+	}
+
+	reqParams := &s3.PutObjectTaggingInput{
+		Bucket:  &bucketName,
+		Key:     &key,
+		Tagging: &awsTypes.Tagging{},
+	}
+
+	for _, k := range sortedKeys(tagMap) {
+		reqParams.Tagging.TagSet = append(reqParams.Tagging.TagSet, awsTypes.Tag{
+			Key:   ncloud.String(k),
+			Value: ncloud.String(tagMap[k]),
+		})
+	}
+
+	tflog.Info(ctx, "PutObjectTagging reqParams="+common.MarshalUncheckedString(reqParams))
+
+	output, err := config.Client.ObjectStorage.PutObjectTagging(ctx, reqParams)
+	if err != nil {
+		diag.AddError("PutObjectTagging ERROR", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "PutObjectTagging response="+common.MarshalUncheckedString(output))
+}
+
+func readObjectTags(ctx context.Context, config *conn.ProviderConfig, bucketName, key string, diag *diag.Diagnostics) types.Map {
+	output, err := config.Client.ObjectStorage.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{
+		Bucket: &bucketName,
+		Key:    &key,
+	})
+	if err != nil {
+		// Object tagging may be unavailable in the region or denied to the key, which is treated as no tags
+		if isObjectTaggingUnavailable(err) {
+			return types.MapNull(types.StringType)
+		}
+		diag.AddError("GetObjectTagging ERROR", err.Error())
+		return types.MapNull(types.StringType)
+	}
+
+	if output == nil || len(output.TagSet) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	tags := make(map[string]string, len(output.TagSet))
+	for _, tag := range output.TagSet {
+		tags[common.StringOrEmpty(tag.Key)] = common.StringOrEmpty(tag.Value)
+	}
+
+	result, diags := types.MapValueFrom(ctx, types.StringType, tags)
+	diag.Append(diags...)
+
+	return result
+}
+
+func isObjectTaggingUnavailable(err error) bool {
+	return strings.Contains(err.Error(), "NotImplemented") || strings.Contains(err.Error(), "AccessDenied")
+}
+
+// objectTagging encodes tags as the URL query string expected by the x-amz-tagging header.
+func objectTagging(ctx context.Context, tags types.Map, diag *diag.Diagnostics) *string {
+	tagMap := stringMap(ctx, tags, diag)
+	if len(tagMap) == 0 {
+		return nil
+	}
+
+	values := url.Values{}
+	for k, v := range tagMap {
+		values.Set(k, v)
+	}
+
+	encoded := values.Encode()
+	return &encoded
+}
+
+func copySource(bucketName, key string) *string {
+	source := fmt.Sprintf("%s/%s", bucketName, url.PathEscape(key))
+	return &source
+}
+
+func stringMap(ctx context.Context, value types.Map, diag *diag.Diagnostics) map[string]string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	result := make(map[string]string, len(value.Elements()))
+	diag.Append(value.ElementsAs(ctx, &result, false)...)
+
+	return result
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func expiresTime(value types.String) *time.Time {
+	if !isKnown(value) {
+		return nil
+	}
+
+	// validated as RFC3339 in schema
+	expires, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil
+	}
+
+	return &expires
+}
+
+func isKnown(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+func knownStringPointer(value types.String) *string {
+	if !isKnown(value) {
+		return nil
+	}
+
+	return value.ValueStringPointer()
+}
+
+// isPlanned reports whether an optional and computed attribute is set to a new value.
+func isPlanned(plan, state types.String) bool {
+	return !plan.IsUnknown() && !plan.Equal(state)
+}
+
+func objectMetadataValidators() []validator.Map {
+	return []validator.Map{
+		mapvalidator.KeysAre(
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^[a-z0-9-]+$`),
+				"Metadata keys must consist of lowercase letters, numbers and hyphens. They are stored with the x-amz-meta- prefix",
+			),
+		),
+	}
+}
+
+func objectTagsValidators() []validator.Map {
+	return []validator.Map{
+		mapvalidator.SizeAtMost(10),
+		mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 128)),
+		mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256)),
+	}
+}
+
+func objectExpiresValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(
+			regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`),
+			"Must be a UTC date in RFC3339 format. ex) 2024-01-01T00:00:00Z",
+		),
+	}
+}
+
+// Ncloud Object Storage only supports the standard storage class
+func objectStorageClassValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf(string(awsTypes.StorageClassStandard)),
+	}
+}
@@ -0,0 +1,60 @@
+package objectstorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestObjectTagging(t *testing.T) {
+	var diags diag.Diagnostics
+
+	tags := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env":  types.StringValue("dev"),
+		"team": types.StringValue("a&b c"),
+	})
+
+	result := objectTagging(context.Background(), tags, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if result == nil || *result != "env=dev&team=a%26b+c" {
+		t.Fatalf("unexpected tagging: %v", result)
+	}
+
+	if result := objectTagging(context.Background(), types.MapNull(types.StringType), &diags); result != nil {
+		t.Fatalf("expected nil tagging for null tags, but got %s", *result)
+	}
+}
+
+func TestObjectHeadersChanged(t *testing.T) {
+	state := objectHeaders{
+		ContentType:  types.StringValue("text/plain"),
+		StorageClass: types.StringValue("STANDARD"),
+		Metadata:     types.MapNull(types.StringType),
+	}
+
+	plan := objectHeaders{
+		ContentType:  types.StringUnknown(),
+		StorageClass: types.StringUnknown(),
+		Metadata:     types.MapNull(types.StringType),
+	}
+
+	if plan.changed(state) {
+		t.Fatal("unknown computed headers must not be detected as changed")
+	}
+
+	plan.CacheControl = types.StringValue("no-cache")
+	if !plan.changed(state) {
+		t.Fatal("expected cache_control to be detected as changed")
+	}
+
+	merged := plan.withState(state)
+	if merged.ContentType.ValueString() != "text/plain" || merged.StorageClass.ValueString() != "STANDARD" {
+		t.Fatalf("expected unknown headers to keep the state value, but got %v", merged)
+	}
+}
@@ -0,0 +1,180 @@
+package objectstorage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awsTypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+const (
+	DefaultMultipartThreshold   = 100 * 1024 * 1024
+	DefaultMultipartPartSize    = 16 * 1024 * 1024
+	DefaultMultipartConcurrency = manager.DefaultUploadConcurrency
+
+	// Attempts of each part, so a failed part does not restart the whole upload
+	multipartMaxAttempts = 5
+)
+
+// multipartUploadOptions holds the multipart settings of an upload, null values fall back to the defaults.
+type multipartUploadOptions struct {
+	Threshold   types.Int64
+	PartSize    types.Int64
+	Concurrency types.Int64
+}
+
+// uploadObject uploads the object with a single PutObject, or with a multipart upload
+// when the body is seekable and at least threshold bytes long. Multipart uploads are verified
+// against the returned ETag. Failed parts are retried, but an interrupted upload is aborted and
+// the next upload starts again from the first part. It returns the version ID and the server side encryption of the object.
+func uploadObject(ctx context.Context, config *conn.ProviderConfig, options multipartUploadOptions, reqParams *s3.PutObjectInput) (*string, awsTypes.ServerSideEncryption, error) {
+	body, seekable := reqParams.Body.(io.ReadSeeker)
+
+	size := int64(-1)
+	if seekable {
+		var err error
+		// Sources such as pipes implement io.Seeker but cannot be seeked, they are sent in a single request
+		if size, err = seekerSize(body); err != nil {
+			seekable = false
+		}
+	}
+
+	threshold := int64(DefaultMultipartThreshold)
+	if !options.Threshold.IsNull() && !options.Threshold.IsUnknown() {
+		threshold = options.Threshold.ValueInt64()
+	}
+
+	if !seekable || size < threshold {
+		tflog.Info(ctx, "PutObject reqParams="+common.MarshalUncheckedString(reqParams))
+
+		output, err := config.Client.ObjectStorage.PutObject(ctx, reqParams)
+		if err != nil {
+			return nil, "", err
+		}
+		if output == nil {
+			return nil, "", fmt.Errorf("response invalid at put object")
+		}
+
+		tflog.Info(ctx, "PutObject response="+common.MarshalUncheckedString(output))
+
+		return output.VersionId, output.ServerSideEncryption, nil
+	}
+
+	partSize := multipartPartSize(options.PartSize, size)
+
+	concurrency := DefaultMultipartConcurrency
+	if !options.Concurrency.IsNull() && !options.Concurrency.IsUnknown() {
+		concurrency = int(options.Concurrency.ValueInt64())
+	}
+
+	uploader := manager.NewUploader(config.Client.ObjectStorage, func(u *manager.Uploader) {
+		u.PartSize = partSize
+		u.Concurrency = concurrency
+		// Uploads are not resumable, the parts of a failed upload are aborted rather than left to be billed
+		u.LeavePartsOnError = false
+		// Applies to every UploadPart request, so each part is retried on its own
+		u.ClientOptions = append(u.ClientOptions, func(o *s3.Options) {
+			o.RetryMaxAttempts = multipartMaxAttempts
+		})
+	})
+
+	tflog.Info(ctx, "Upload reqParams="+common.MarshalUncheckedString(reqParams))
+
+	output, err := uploader.Upload(ctx, reqParams)
+	if err != nil {
+		return nil, "", err
+	}
+	if output == nil {
+		return nil, "", fmt.Errorf("response invalid at multipart upload")
+	}
+
+	tflog.Info(ctx, "Upload response="+common.MarshalUncheckedString(output))
+
+	// ETag of a KMS encrypted object is not derived from MD5 digests
+	if output.ServerSideEncryption == awsTypes.ServerSideEncryptionAwsKms || output.ETag == nil {
+		return output.VersionID, output.ServerSideEncryption, nil
+	}
+
+	expected, err := multipartETag(body, size, partSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if etag := strings.Trim(*output.ETag, `"`); etag != expected {
+		return nil, "", fmt.Errorf("checksum mismatch after multipart upload of %s: expected ETag %s, got %s", *reqParams.Key, expected, etag)
+	}
+
+	return output.VersionID, output.ServerSideEncryption, nil
+}
+
+// multipartPartSize returns the part size, increased if necessary to stay within the maximum number of parts.
+func multipartPartSize(configured types.Int64, size int64) int64 {
+	partSize := int64(DefaultMultipartPartSize)
+	if !configured.IsNull() && !configured.IsUnknown() {
+		partSize = configured.ValueInt64()
+	}
+
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = size/int64(manager.MaxUploadParts) + 1
+	}
+
+	return partSize
+}
+
+// multipartETag computes the ETag expected for an object uploaded in parts of partSize,
+// which is the MD5 digest of the concatenated part digests followed by the number of parts.
+func multipartETag(body io.ReadSeeker, size, partSize int64) (string, error) {
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	if size <= partSize {
+		hash := md5.New()
+		if _, err := io.Copy(hash, body); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	var digests []byte
+	parts := 0
+	for remaining := size; remaining > 0; remaining -= partSize {
+		hash := md5.New()
+		if _, err := io.CopyN(hash, body, min(partSize, remaining)); err != nil {
+			return "", err
+		}
+		digests = hash.Sum(digests)
+		parts++
+	}
+
+	sum := md5.Sum(digests)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+func seekerSize(body io.Seeker) (int64, error) {
+	current, err := body.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+
+	end, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := body.Seek(current, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return end - current, nil
+}
@@ -0,0 +1,45 @@
+package objectstorage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMultipartETag(t *testing.T) {
+	cases := []struct {
+		partSize int64
+		expected string
+	}{
+		{partSize: 4, expected: "446feba4c1b5cc7ad93bf4d44a0e36ac-3"},
+		{partSize: 10, expected: "a925576942e94b2ef57a066101b48876"},
+		{partSize: 16, expected: "a925576942e94b2ef57a066101b48876"},
+	}
+
+	for _, c := range cases {
+		body := strings.NewReader("abcdefghij")
+
+		result, err := multipartETag(body, body.Size(), c.partSize)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if result != c.expected {
+			t.Fatalf("expected ETag with part size %d to be %s, but got %s", c.partSize, c.expected, result)
+		}
+	}
+}
+
+func TestMultipartPartSize(t *testing.T) {
+	if result := multipartPartSize(types.Int64Null(), 1024); result != DefaultMultipartPartSize {
+		t.Fatalf("expected default part size %d, but got %d", DefaultMultipartPartSize, result)
+	}
+
+	size := manager.MinUploadPartSize * int64(manager.MaxUploadParts) * 2
+	result := multipartPartSize(types.Int64Value(manager.MinUploadPartSize), size)
+	if (size+result-1)/result > int64(manager.MaxUploadParts) {
+		t.Fatalf("part size %d exceeds the maximum number of parts for %d bytes", result, size)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -154,6 +155,212 @@ func TestAccResourceNcloudObjectStorage_object_update_content_type(t *testing.T)
 	})
 }
 
+func TestAccResourceNcloudObjectStorage_object_versioning(t *testing.T) {
+	var versionId string
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	newSourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	key := "test/key/" + sourceName
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	tmpFile := CreateTempFile(t, "content for file upload testing", sourceName)
+	source := tmpFile.Name()
+	defer os.Remove(source)
+
+	newTmpFile := CreateTempFile(t, "new content for file update testing", newSourceName)
+	newSource := newTmpFile.Name()
+	defer os.Remove(newSource)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectVersioningConfig(bucketName, key, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttrWith(resourceName, "version_id", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("version_id is not set")
+						}
+						versionId = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccObjectVersioningConfig(bucketName, key, newSource),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttrWith(resourceName, "version_id", func(value string) error {
+						if value == "" || value == versionId {
+							return fmt.Errorf("version_id is not changed after update: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_object_content(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	key := fmt.Sprintf("test/key/%s.txt", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectContentConfig(bucketName, key, "content", "inline content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content", "inline content"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "14"),
+				),
+			},
+			{
+				Config: testAccObjectContentConfig(bucketName, key, "content", "updated inline content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content", "updated inline content"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "22"),
+				),
+			},
+			{
+				// base64 of "base64 content"
+				Config: testAccObjectContentConfig(bucketName, key, "content_base64", "YmFzZTY0IGNvbnRlbnQ="),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckNoResourceAttr(resourceName, "content"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_object_source_edited(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	sourceName := fmt.Sprintf("%s.md", acctest.RandString(5))
+	key := "test/key/" + sourceName
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	tmpFile := CreateTempFile(t, "content for file upload testing", sourceName)
+	source := tmpFile.Name()
+	defer os.Remove(source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig(bucketName, key, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "31"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(source, []byte("edited content"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectConfig(bucketName, key, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_object_multipart(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	sourceName := fmt.Sprintf("%s.bin", acctest.RandString(5))
+	key := "test/key/" + sourceName
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	// 12MiB uploaded in three parts of 5MiB
+	tmpFile := CreateTempFile(t, strings.Repeat("0123456789abcdef", 12*1024*1024/16), sourceName)
+	source := tmpFile.Name()
+	defer os.Remove(source)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectMultipartConfig(bucketName, key, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "12582912"),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`-3"?$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudObjectStorage_object_metadata(t *testing.T) {
+	bucketName := fmt.Sprintf("tf-bucket-%s", acctest.RandString(5))
+	key := fmt.Sprintf("test/key/%s.txt", acctest.RandString(5))
+	resourceName := "ncloud_objectstorage_object.testing_object"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectMetadataConfig(bucketName, key, "first", "dev", "no-cache"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.owner", "first"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "dev"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "no-cache"),
+					resource.TestCheckResourceAttr(resourceName, "content_disposition", "attachment; filename=\"metadata.txt\""),
+					resource.TestCheckResourceAttr(resourceName, "expires", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
+				),
+			},
+			{
+				Config: testAccObjectMetadataConfig(bucketName, key, "second", "dev", "max-age=60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "metadata.owner", "second"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=60"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "16"),
+				),
+			},
+			{
+				Config: testAccObjectMetadataConfig(bucketName, key, "second", "prod", "max-age=60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(resourceName, TestAccProvider),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.owner", "second"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
+
 func testAccCheckObjectExists(n string, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resource, ok := s.RootModule().Resources[n]
@@ -219,6 +426,24 @@ func testAccObjectConfig(bucketName, key, source string) string {
 	}`, bucketName, key, source)
 }
 
+func testAccObjectVersioningConfig(bucketName, key, source string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_bucket_versioning" "testing_versioning" {
+		bucket_name			= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		status				= "Enabled"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket_versioning.testing_versioning.bucket_name
+		key 				= "%[2]s"
+		source				= "%[3]s"
+	}`, bucketName, key, source)
+}
+
 func testAccObjectContentType(bucketName, key, source, contentType string) string {
 	return fmt.Sprintf(`
 	resource "ncloud_objectstorage_bucket" "testing_bucket" {
@@ -233,6 +458,60 @@ func testAccObjectContentType(bucketName, key, source, contentType string) strin
 	}`, bucketName, key, source, contentType)
 }
 
+func testAccObjectContentConfig(bucketName, key, attribute, value string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		key 				= "%[2]s"
+		%[3]s				= "%[4]s"
+	}`, bucketName, key, attribute, value)
+}
+
+func testAccObjectMultipartConfig(bucketName, key, source string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket					= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		key 					= "%[2]s"
+		source					= "%[3]s"
+		multipart_threshold		= 5242880
+		multipart_part_size		= 5242880
+		multipart_concurrency	= 3
+	}`, bucketName, key, source)
+}
+
+func testAccObjectMetadataConfig(bucketName, key, owner, env, cacheControl string) string {
+	return fmt.Sprintf(`
+	resource "ncloud_objectstorage_bucket" "testing_bucket" {
+		bucket_name			= "%[1]s"
+	}
+
+	resource "ncloud_objectstorage_object" "testing_object" {
+		bucket				= ncloud_objectstorage_bucket.testing_bucket.bucket_name
+		key 				= "%[2]s"
+		content				= "metadata content"
+		content_type		= "text/plain"
+		cache_control		= "%[5]s"
+		content_disposition	= "attachment; filename=\"metadata.txt\""
+		expires				= "2030-01-01T00:00:00Z"
+
+		metadata = {
+			owner = "%[3]s"
+		}
+
+		tags = {
+			env = "%[4]s"
+		}
+	}`, bucketName, key, owner, env, cacheControl)
+}
+
 func CreateTempFile(t *testing.T, content, key string) *os.File {
 	tmpFile, err := os.CreateTemp("", key)
 	if err != nil {
@@ -0,0 +1,155 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = PolicyType{}
+	_ basetypes.StringValuableWithSemanticEquals = PolicyValue{}
+)
+
+// PolicyType is a string type holding a policy document. Values that only differ in
+// formatting or key order are treated as semantically equal, so they do not produce a diff.
+type PolicyType struct {
+	basetypes.StringType
+}
+
+func (t PolicyType) String() string {
+	return "objectstorage.PolicyType"
+}
+
+func (t PolicyType) ValueType(_ context.Context) attr.Value {
+	return PolicyValue{}
+}
+
+func (t PolicyType) Equal(o attr.Type) bool {
+	other, ok := o.(PolicyType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t PolicyType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PolicyValue{StringValue: in}, nil
+}
+
+func (t PolicyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+type PolicyValue struct {
+	basetypes.StringValue
+}
+
+func NewPolicyValue(value string) PolicyValue {
+	return PolicyValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewPolicyNull() PolicyValue {
+	return PolicyValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v PolicyValue) Type(_ context.Context) attr.Type {
+	return PolicyType{}
+}
+
+func (v PolicyValue) Equal(o attr.Value) bool {
+	other, ok := o.(PolicyValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v PolicyValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PolicyValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return PolicyEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
+
+// PolicyEquivalent reports whether two policy documents are semantically equal.
+// Key order, whitespace, a single value versus a one-element list and the order of string lists are ignored.
+func PolicyEquivalent(a, b string) bool {
+	var docA, docB interface{}
+
+	if err := json.Unmarshal([]byte(a), &docA); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(b), &docB); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(normalizePolicyValue(docA), normalizePolicyValue(docB))
+}
+
+func normalizePolicyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			normalized[key] = normalizePolicyValue(elem)
+		}
+		return normalized
+	case []interface{}:
+		if len(v) == 1 {
+			return normalizePolicyValue(v[0])
+		}
+
+		normalized := make([]interface{}, len(v))
+		strs := make([]string, 0, len(v))
+		for i, elem := range v {
+			normalized[i] = normalizePolicyValue(elem)
+			if s, ok := normalized[i].(string); ok {
+				strs = append(strs, s)
+			}
+		}
+
+		if len(strs) == len(v) {
+			sort.Strings(strs)
+			for i, s := range strs {
+				normalized[i] = s
+			}
+		}
+		return normalized
+	default:
+		return v
+	}
+}
@@ -0,0 +1,317 @@
+package objectstorage
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+)
+
+const (
+	DefaultPolicyVersion = "2012-10-17"
+)
+
+var (
+	_ datasource.DataSource                   = &policyDocumentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &policyDocumentDataSource{}
+)
+
+func NewPolicyDocumentDataSource() datasource.DataSource {
+	return &policyDocumentDataSource{}
+}
+
+type policyDocumentDataSource struct{}
+
+func (p *policyDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_objectstorage_policy_document"
+}
+
+func (p *policyDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	principalAttribute := schema.ListNestedAttribute{
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required: true,
+				},
+				"identifiers": schema.ListAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"policy_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"statement": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							Optional: true,
+						},
+						"effect": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Allow", "Deny"),
+							},
+						},
+						"actions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"not_actions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"resources": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"not_resources": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"principals":     principalAttribute,
+						"not_principals": principalAttribute,
+						"conditions": schema.ListNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"test": schema.StringAttribute{
+										Required: true,
+									},
+									"variable": schema.StringAttribute{
+										Required: true,
+									},
+									"values": schema.ListAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"json": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (p *policyDocumentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data policyDocumentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, s := range data.Statement {
+		validatePolicyPrincipals(path.Root("statement").AtListIndex(i).AtName("principals"), s.Principals, &resp.Diagnostics)
+		validatePolicyPrincipals(path.Root("statement").AtListIndex(i).AtName("not_principals"), s.NotPrincipals, &resp.Diagnostics)
+	}
+}
+
+func validatePolicyPrincipals(attrPath path.Path, principals []policyPrincipalModel, diag *diag.Diagnostics) {
+	if len(principals) > 1 && hasAnonymousPrincipal(principals) {
+		diag.AddAttributeError(
+			attrPath,
+			"Invalid Attribute Combination",
+			"A principal with type and identifiers set to \"*\" cannot be combined with other principals",
+		)
+	}
+}
+
+func (p *policyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data policyDocumentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Version.IsNull() || data.Version.IsUnknown() {
+		data.Version = types.StringValue(DefaultPolicyVersion)
+	}
+
+	doc := policyDocument{
+		Version:   data.Version.ValueString(),
+		Id:        data.PolicyId.ValueString(),
+		Statement: []policyStatement{},
+	}
+
+	for _, s := range data.Statement {
+		statement := policyStatement{
+			Sid:         s.Sid.ValueString(),
+			Effect:      "Allow",
+			Action:      policyStringOrList(policyStringList(s.Actions)),
+			NotAction:   policyStringOrList(policyStringList(s.NotActions)),
+			Resource:    policyStringOrList(policyStringList(s.Resources)),
+			NotResource: policyStringOrList(policyStringList(s.NotResources)),
+		}
+
+		if !s.Effect.IsNull() {
+			statement.Effect = s.Effect.ValueString()
+		}
+
+		statement.Principal = expandPolicyPrincipals(s.Principals)
+		statement.NotPrincipal = expandPolicyPrincipals(s.NotPrincipals)
+
+		if len(s.Conditions) > 0 {
+			statement.Condition = make(map[string]map[string]interface{})
+			for _, c := range s.Conditions {
+				test := c.Test.ValueString()
+				if _, ok := statement.Condition[test]; !ok {
+					statement.Condition[test] = make(map[string]interface{})
+				}
+				statement.Condition[test][c.Variable.ValueString()] = policyStringOrList(policyStringList(c.Values))
+			}
+		}
+
+		doc.Statement = append(doc.Statement, statement)
+	}
+
+	output, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	data.Json = types.StringValue(string(output))
+	data.ID = types.StringValue(strconv.Itoa(common.Hashcode(string(output))))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func expandPolicyPrincipals(principals []policyPrincipalModel) interface{} {
+	if len(principals) == 0 {
+		return nil
+	}
+
+	// Anonymous access is expressed as a bare "*"
+	if len(principals) == 1 && hasAnonymousPrincipal(principals) {
+		return "*"
+	}
+
+	// Identifiers of principals sharing a type are merged
+	identifiers := make(map[string][]string)
+	for _, p := range principals {
+		identifiers[p.Type.ValueString()] = append(identifiers[p.Type.ValueString()], policyStringList(p.Identifiers)...)
+	}
+
+	result := make(map[string]interface{})
+	for principalType, values := range identifiers {
+		result[principalType] = policyStringOrList(values)
+	}
+
+	return result
+}
+
+func hasAnonymousPrincipal(principals []policyPrincipalModel) bool {
+	for _, p := range principals {
+		if p.Type.IsUnknown() || p.Identifiers.IsUnknown() {
+			continue
+		}
+
+		identifiers := policyStringList(p.Identifiers)
+		if p.Type.ValueString() == "*" && len(identifiers) == 1 && identifiers[0] == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyStringList(values types.List) []string {
+	result := make([]string, 0, len(values.Elements()))
+
+	for _, v := range values.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			result = append(result, s.ValueString())
+		}
+	}
+
+	return result
+}
+
+func policyStringOrList(values []string) interface{} {
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		return values
+	}
+}
+
+type policyDocument struct {
+	Version   string            `json:"Version,omitempty"`
+	Id        string            `json:"Id,omitempty"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Sid          string                            `json:"Sid,omitempty"`
+	Effect       string                            `json:"Effect"`
+	Principal    interface{}                       `json:"Principal,omitempty"`
+	NotPrincipal interface{}                       `json:"NotPrincipal,omitempty"`
+	Action       interface{}                       `json:"Action,omitempty"`
+	NotAction    interface{}                       `json:"NotAction,omitempty"`
+	Resource     interface{}                       `json:"Resource,omitempty"`
+	NotResource  interface{}                       `json:"NotResource,omitempty"`
+	Condition    map[string]map[string]interface{} `json:"Condition,omitempty"`
+}
+
+type policyDocumentDataSourceModel struct {
+	ID        types.String           `tfsdk:"id"`
+	Version   types.String           `tfsdk:"version"`
+	PolicyId  types.String           `tfsdk:"policy_id"`
+	Statement []policyStatementModel `tfsdk:"statement"`
+	Json      types.String           `tfsdk:"json"`
+}
+
+type policyStatementModel struct {
+	Sid           types.String           `tfsdk:"sid"`
+	Effect        types.String           `tfsdk:"effect"`
+	Actions       types.List             `tfsdk:"actions"`
+	NotActions    types.List             `tfsdk:"not_actions"`
+	Resources     types.List             `tfsdk:"resources"`
+	NotResources  types.List             `tfsdk:"not_resources"`
+	Principals    []policyPrincipalModel `tfsdk:"principals"`
+	NotPrincipals []policyPrincipalModel `tfsdk:"not_principals"`
+	Conditions    []policyConditionModel `tfsdk:"conditions"`
+}
+
+type policyPrincipalModel struct {
+	Type        types.String `tfsdk:"type"`
+	Identifiers types.List   `tfsdk:"identifiers"`
+}
+
+type policyConditionModel struct {
+	Test     types.String `tfsdk:"test"`
+	Variable types.String `tfsdk:"variable"`
+	Values   types.List   `tfsdk:"values"`
+}
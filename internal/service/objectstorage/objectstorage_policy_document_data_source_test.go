@@ -0,0 +1,124 @@
+package objectstorage_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+)
+
+func TestAccDataSourceNcloudObjectStorage_policy_document_basic(t *testing.T) {
+	dataName := "data.ncloud_objectstorage_policy_document.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePolicyDocumentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "version", "2012-10-17"),
+					resource.TestCheckResourceAttr(dataName, "json", testAccPolicyDocumentExpectedJSON),
+				),
+			},
+			{
+				Config:      testAccDataSourcePolicyDocumentMixedPrincipalsConfig,
+				ExpectError: regexp.MustCompile("cannot be combined with other principals"),
+			},
+		},
+	})
+}
+
+const testAccDataSourcePolicyDocumentConfig = `
+data "ncloud_objectstorage_policy_document" "test" {
+	statement = [
+		{
+			sid			= "AllowList"
+			actions		= ["s3:ListBucket"]
+			resources	= ["arn:aws:s3:::my-bucket"]
+			principals	= [
+				{
+					type		= "NCP"
+					identifiers	= ["123456789", "987654321"]
+				}
+			]
+			conditions	= [
+				{
+					test		= "StringLike"
+					variable	= "s3:prefix"
+					values		= ["home/"]
+				}
+			]
+		},
+		{
+			effect		= "Deny"
+			actions		= ["s3:DeleteObject", "s3:DeleteBucket"]
+			resources	= ["arn:aws:s3:::my-bucket", "arn:aws:s3:::my-bucket/*"]
+			principals	= [
+				{
+					type		= "*"
+					identifiers	= ["*"]
+				}
+			]
+		}
+	]
+}
+`
+
+const testAccDataSourcePolicyDocumentMixedPrincipalsConfig = `
+data "ncloud_objectstorage_policy_document" "test" {
+	statement = [
+		{
+			actions		= ["s3:GetObject"]
+			resources	= ["arn:aws:s3:::my-bucket/*"]
+			principals	= [
+				{
+					type		= "*"
+					identifiers	= ["*"]
+				},
+				{
+					type		= "NCP"
+					identifiers	= ["123456789"]
+				}
+			]
+		}
+	]
+}
+`
+
+const testAccPolicyDocumentExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowList",
+      "Effect": "Allow",
+      "Principal": {
+        "NCP": [
+          "123456789",
+          "987654321"
+        ]
+      },
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::my-bucket",
+      "Condition": {
+        "StringLike": {
+          "s3:prefix": "home/"
+        }
+      }
+    },
+    {
+      "Effect": "Deny",
+      "Principal": "*",
+      "Action": [
+        "s3:DeleteObject",
+        "s3:DeleteBucket"
+      ],
+      "Resource": [
+        "arn:aws:s3:::my-bucket",
+        "arn:aws:s3:::my-bucket/*"
+      ]
+    }
+  ]
+}`
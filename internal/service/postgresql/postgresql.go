@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -358,6 +359,24 @@ func (r *postgresqlResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"writer_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reader_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_uri": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"generation_code": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -778,6 +797,9 @@ type postgresqlResourceModel struct {
 	Port                      types.Int64  `tfsdk:"port"`
 	RegionCode                types.String `tfsdk:"region_code"`
 	GenerationCode            types.String `tfsdk:"generation_code"`
+	WriterEndpoint            types.String `tfsdk:"writer_endpoint"`
+	ReaderEndpoint            types.String `tfsdk:"reader_endpoint"`
+	ConnectionUri             types.String `tfsdk:"connection_uri"`
 	AccessControlGroupNoList  types.List   `tfsdk:"access_control_group_no_list"`
 	PostgresqlConfigList      types.List   `tfsdk:"postgresql_config_list"`
 	PostgresqlServerList      types.List   `tfsdk:"postgresql_server_list"`
@@ -839,6 +861,7 @@ func (r *postgresqlResourceModel) refreshFromOutput(ctx context.Context, output
 	r.Port = common.Int64ValueFromInt32(output.CloudPostgresqlPort)
 	r.RegionCode = types.StringPointerValue(output.CloudPostgresqlServerInstanceList[0].RegionCode)
 	r.GenerationCode = types.StringPointerValue(output.GenerationCode)
+	r.refreshEndpoints(output)
 
 	acgList, diags := types.ListValueFrom(ctx, types.StringType, output.AccessControlGroupNoList)
 	if diags.HasError() {
@@ -856,6 +879,48 @@ func (r *postgresqlResourceModel) refreshFromOutput(ctx context.Context, output
 	return diags
 }
 
+// refreshEndpoints derives the connection endpoints from the private domains of the primary and the first read replica server.
+func (r *postgresqlResourceModel) refreshEndpoints(output *vpostgresql.CloudPostgresqlInstance) {
+	r.WriterEndpoint = types.StringNull()
+	r.ReaderEndpoint = types.StringNull()
+	r.ConnectionUri = types.StringNull()
+
+	if output.CloudPostgresqlPort == nil {
+		return
+	}
+
+	for _, server := range output.CloudPostgresqlServerInstanceList {
+		role := common.GetCodePtrByCommonCode(server.CloudPostgresqlServerRole)
+		if role == nil || server.PrivateDomain == nil {
+			continue
+		}
+
+		endpoint := fmt.Sprintf("%s:%d", *server.PrivateDomain, *output.CloudPostgresqlPort)
+		switch *role {
+		case "M":
+			r.WriterEndpoint = types.StringValue(endpoint)
+		case "S":
+			if r.ReaderEndpoint.IsNull() {
+				r.ReaderEndpoint = types.StringValue(endpoint)
+			}
+		}
+	}
+
+	if r.WriterEndpoint.IsNull() {
+		return
+	}
+
+	uri := url.URL{
+		Scheme: "postgresql",
+		Host:   r.WriterEndpoint.ValueString(),
+		Path:   "/" + r.DatabaseName.ValueString(),
+	}
+	if !r.UserName.IsNull() && !r.UserName.IsUnknown() {
+		uri.User = url.User(r.UserName.ValueString())
+	}
+	r.ConnectionUri = types.StringValue(uri.String())
+}
+
 func listValueFromPostgresqlServerInatanceList(ctx context.Context, serverInatances []*vpostgresql.CloudPostgresqlServerInstance) (basetypes.ListValue, diag.Diagnostics) {
 	var serverList []postgresqlServer
 	for _, server := range serverInatances {
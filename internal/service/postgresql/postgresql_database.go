@@ -0,0 +1,255 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &postgresqlDatabaseResource{}
+	_ resource.ResourceWithConfigure   = &postgresqlDatabaseResource{}
+	_ resource.ResourceWithImportState = &postgresqlDatabaseResource{}
+)
+
+func NewPostgresqlDatabaseResource() resource.Resource {
+	return &postgresqlDatabaseResource{}
+}
+
+type postgresqlDatabaseResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *postgresqlDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: postgresql_instance_no:name Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("postgresql_instance_no"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+func (r *postgresqlDatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *postgresqlDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresql_database"
+}
+
+func (r *postgresqlDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"postgresql_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(4, 16),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z]+[a-z0-9_]+$`),
+						"Composed of lowercase alphabets, numbers, underbar (_). Must start with an alphabetic character.",
+					),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *postgresqlDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan postgresqlDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to users and databases of an instance are rejected while another one is in progress
+	conn.GlobalMutexKV.Lock(plan.PostgresqlInstanceNo.ValueString())
+	defer conn.GlobalMutexKV.Unlock(plan.PostgresqlInstanceNo.ValueString())
+
+	_, err := WaitPostgresqlCreation(ctx, r.config, plan.PostgresqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WATING FOR POSTGRESQL CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vpostgresql.AddCloudPostgresqlDatabaseListRequest{
+		RegionCode:                &r.config.RegionCode,
+		CloudPostgresqlInstanceNo: plan.PostgresqlInstanceNo.ValueStringPointer(),
+		CloudPostgresqlDatabaseList: []*vpostgresql.CloudPostgresqlDatabaseParameter{
+			{
+				Name:  plan.Name.ValueStringPointer(),
+				Owner: plan.Owner.ValueStringPointer(),
+			},
+		},
+	}
+	tflog.Info(ctx, "CreatePostgresqlDatabase reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := r.config.Client.Vpostgresql.V2Api.AddCloudPostgresqlDatabaseList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "CreatePostgresqlDatabase response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
+		return
+	}
+
+	_, err = WaitPostgresqlCreation(ctx, r.config, plan.PostgresqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WATING FOR POSTGRESQL CREATION ERROR", err.Error())
+		return
+	}
+
+	output, err := GetPostgresqlDatabaseList(ctx, r.config, plan.PostgresqlInstanceNo.ValueString(), []string{plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.Diagnostics.AddError("READING ERROR", "no result. database is not found after creation")
+		return
+	}
+
+	plan.refreshFromOutput(output[0], plan.PostgresqlInstanceNo.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *postgresqlDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state postgresqlDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetPostgresqlDatabaseList(ctx, r.config, state.PostgresqlInstanceNo.ValueString(), []string{state.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refreshFromOutput(output[0], state.PostgresqlInstanceNo.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *postgresqlDatabaseResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *postgresqlDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state postgresqlDatabaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to users and databases of an instance are rejected while another one is in progress
+	conn.GlobalMutexKV.Lock(state.PostgresqlInstanceNo.ValueString())
+	defer conn.GlobalMutexKV.Unlock(state.PostgresqlInstanceNo.ValueString())
+
+	_, err := WaitPostgresqlCreation(ctx, r.config, state.PostgresqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WATING FOR POSTGRESQL CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vpostgresql.DeleteCloudPostgresqlDatabaseListRequest{
+		RegionCode:                &r.config.RegionCode,
+		CloudPostgresqlInstanceNo: state.PostgresqlInstanceNo.ValueStringPointer(),
+		CloudPostgresqlDatabaseList: []*vpostgresql.CloudPostgresqlDatabaseKeyParameter{
+			{
+				Name: state.Name.ValueStringPointer(),
+			},
+		},
+	}
+	tflog.Info(ctx, "DeletePostgresqlDatabase reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := r.config.Client.Vpostgresql.V2Api.DeleteCloudPostgresqlDatabaseList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DeletePostgresqlDatabase response="+common.MarshalUncheckedString(response))
+
+	_, err = WaitPostgresqlCreation(ctx, r.config, state.PostgresqlInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
+		return
+	}
+}
+
+type postgresqlDatabaseResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	PostgresqlInstanceNo types.String `tfsdk:"postgresql_instance_no"`
+	Name                 types.String `tfsdk:"name"`
+	Owner                types.String `tfsdk:"owner"`
+}
+
+func (r *postgresqlDatabaseResourceModel) refreshFromOutput(output *vpostgresql.CloudPostgresqlDatabase, instanceNo string) {
+	r.ID = types.StringValue(fmt.Sprintf("%s:%s", instanceNo, *output.DatabaseName))
+	r.PostgresqlInstanceNo = types.StringValue(instanceNo)
+	r.Name = types.StringPointerValue(output.DatabaseName)
+	r.Owner = types.StringPointerValue(output.Owner)
+}
@@ -0,0 +1,105 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	postgresqlservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/postgresql"
+)
+
+func TestAccResourceNcloudPostgresqlDatabase_vpc_basic(t *testing.T) {
+	testName := fmt.Sprintf("tf-postgresqldb-%s", acctest.RandString(5))
+	resourceName := "ncloud_postgresql_database.postgresql_db"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPostgresqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPostgresqlDatabaseConfig(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "testdb"),
+					resource.TestCheckResourceAttr(resourceName, "owner", "testowner"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPostgresqlDatabaseConfig(testPostgresqlName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-2"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PUBLIC"
+}
+
+resource "ncloud_postgresql" "postgresql" {
+	vpc_no            = ncloud_vpc.test_vpc.vpc_no
+	subnet_no         = ncloud_subnet.test_subnet.id
+	service_name      = "%[1]s"
+	server_name_prefix = "testprefix"
+	user_name         = "testusername"
+	user_password     = "t123456789!a"
+	client_cidr       = "0.0.0.0/0"
+	database_name     = "test_db"
+}
+
+resource "ncloud_postgresql_user" "postgresql_user" {
+	postgresql_instance_no = ncloud_postgresql.postgresql.id
+	name = "testowner"
+	password = "t123456789!"
+	client_cidr = "0.0.0.0/0"
+	replication_role = false
+}
+
+resource "ncloud_postgresql_database" "postgresql_db" {
+	postgresql_instance_no = ncloud_postgresql.postgresql.id
+	name = "testdb"
+	owner = ncloud_postgresql_user.postgresql_user.name
+}
+`, testPostgresqlName)
+}
+
+func testAccCheckPostgresqlDatabaseDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_postgresql_database" {
+			continue
+		}
+
+		dbs, err := postgresqlservice.GetPostgresqlDatabaseList(context.Background(), config, rs.Primary.Attributes["postgresql_instance_no"], []string{rs.Primary.Attributes["name"]})
+		if err != nil && !checkNoInstanceResponse(err) {
+			return err
+		}
+
+		if len(dbs) > 0 {
+			return errors.New("postgresql database still exists")
+		}
+	}
+
+	return nil
+}
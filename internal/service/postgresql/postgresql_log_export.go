@@ -0,0 +1,440 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpostgresql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource               = &postgresqlLogExportResource{}
+	_ resource.ResourceWithConfigure  = &postgresqlLogExportResource{}
+	_ resource.ResourceWithModifyPlan = &postgresqlLogExportResource{}
+)
+
+func NewPostgresqlLogExportResource() resource.Resource {
+	return &postgresqlLogExportResource{}
+}
+
+type postgresqlLogExportResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *postgresqlLogExportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *postgresqlLogExportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresql_log_export"
+}
+
+func (r *postgresqlLogExportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"postgresql_server_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("POSTGRESQL_LOG", "POSTGRESQL_UPGRADE_LOG"),
+				},
+			},
+			"bucket_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"exported_file_list": schema.ListNestedAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"file_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"file_date": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *postgresqlLogExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan postgresqlLogExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new server may have no log file yet, they are exported by Update as they appear
+	logs, missing, err := listPostgresqlLogsToExport(ctx, r.config, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	warnMissingLogFiles(&resp.Diagnostics, missing)
+
+	if err := r.exportLogs(ctx, &plan, logs); err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+
+	if diags := plan.refreshFromOutput(ctx, logs); diags.HasError() {
+		resp.Diagnostics.AddError("CREATING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Exported files are plain objects in the bucket, so Read only checks that the server still exists.
+// Log files generated since the last export are detected in plan and exported by Update.
+func (r *postgresqlLogExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state postgresqlLogExportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := GetPostgresqlDbServerLogList(ctx, r.config, state.PostgresqlServerInstanceNo.ValueString(), state.LogType.ValueString())
+	if err != nil {
+		// If the server is already deleted, it will respond with a 400 error with a 5001017 return code.
+		if strings.Contains(err.Error(), `"returnCode": "5001017"`) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update exports the log files that were added or written to since the last export.
+func (r *postgresqlLogExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state postgresqlLogExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logs, missing, err := listPostgresqlLogsToExport(ctx, r.config, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+	warnMissingLogFiles(&resp.Diagnostics, missing)
+
+	if err := r.exportLogs(ctx, &plan, changedPostgresqlDbServerLogs(logs, state.ExportedFileList)); err != nil {
+		resp.Diagnostics.AddError("UPDATING ERROR", err.Error())
+		return
+	}
+
+	if diags := plan.refreshFromOutput(ctx, logs); diags.HasError() {
+		resp.Diagnostics.AddError("UPDATING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Deleting the resource leaves the exported files in the bucket.
+func (r *postgresqlLogExportResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ModifyPlan plans an update when log files were added or rotated away since the last export,
+// so that the next apply delivers the new logs to the bucket. Logs still being written to are
+// exported again along with them, not on their own.
+func (r *postgresqlLogExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state postgresqlLogExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PostgresqlServerInstanceNo.IsUnknown() || plan.LogType.IsUnknown() || plan.FileNames.IsUnknown() {
+		return
+	}
+
+	logs, _, err := listPostgresqlLogsToExport(ctx, r.config, &plan)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to list log files", err.Error())
+		return
+	}
+
+	if postgresqlLogFileNamesChanged(logs, state.ExportedFileList) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("exported_file_list"), types.ListUnknown(types.ObjectType{AttrTypes: postgresqlExportedLog{}.attrTypes()}))...)
+	}
+}
+
+func (r *postgresqlLogExportResource) exportLogs(ctx context.Context, plan *postgresqlLogExportResourceModel, logs []*vpostgresql.CloudPostgresqlDbServerLog) error {
+	for _, log := range logs {
+		reqParams := &vpostgresql.ExportDbServerLogToObjectStorageRequest{
+			RegionCode:                      &r.config.RegionCode,
+			LogType:                         plan.LogType.ValueStringPointer(),
+			FileName:                        log.FileName,
+			BucketName:                      plan.BucketName.ValueStringPointer(),
+			CloudPostgresqlServerInstanceNo: plan.PostgresqlServerInstanceNo.ValueStringPointer(),
+		}
+		tflog.Info(ctx, "ExportPostgresqlDbServerLog reqParams="+common.MarshalUncheckedString(reqParams))
+
+		response, err := r.config.Client.Vpostgresql.V2Api.ExportDbServerLogToObjectStorage(reqParams)
+		if err != nil {
+			return err
+		}
+		tflog.Info(ctx, "ExportPostgresqlDbServerLog response="+common.MarshalUncheckedString(response))
+
+		if response == nil || *response.ReturnCode != "0" {
+			return fmt.Errorf("response invalid")
+		}
+	}
+
+	return nil
+}
+
+// listPostgresqlLogsToExport returns the log files of log_type, limited to file_names when it is set,
+// along with the names in file_names that no longer exist on the server.
+func listPostgresqlLogsToExport(ctx context.Context, config *conn.ProviderConfig, plan *postgresqlLogExportResourceModel) ([]*vpostgresql.CloudPostgresqlDbServerLog, []string, error) {
+	logs, err := GetPostgresqlDbServerLogList(ctx, config, plan.PostgresqlServerInstanceNo.ValueString(), plan.LogType.ValueString())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if plan.FileNames.IsNull() || plan.FileNames.IsUnknown() {
+		return logs, nil, nil
+	}
+
+	var fileNames []string
+	if diags := plan.FileNames.ElementsAs(ctx, &fileNames, false); diags.HasError() {
+		return nil, nil, fmt.Errorf("invalid file_names")
+	}
+
+	filtered, missing := filterPostgresqlDbServerLogs(logs, fileNames)
+	return filtered, missing, nil
+}
+
+func warnMissingLogFiles(diags *diag.Diagnostics, missing []string) {
+	for _, name := range missing {
+		diags.AddWarning("Log file not found", fmt.Sprintf("log file %s no longer exists on the server and is not exported", name))
+	}
+}
+
+func GetPostgresqlDbServerLogList(ctx context.Context, config *conn.ProviderConfig, serverInstanceNo string, logType string) ([]*vpostgresql.CloudPostgresqlDbServerLog, error) {
+	reqParams := &vpostgresql.GetDbServerLogListRequest{
+		RegionCode:                      &config.RegionCode,
+		LogType:                         ncloud.String(logType),
+		CloudPostgresqlServerInstanceNo: ncloud.String(serverInstanceNo),
+	}
+	tflog.Info(ctx, "GetPostgresqlDbServerLogList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vpostgresql.V2Api.GetDbServerLogList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	tflog.Info(ctx, "GetPostgresqlDbServerLogList response="+common.MarshalUncheckedString(resp))
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.CloudPostgresqlDbServerLogList, nil
+}
+
+// filterPostgresqlDbServerLogs keeps the log files listed in fileNames. Rotated files are returned as missing.
+func filterPostgresqlDbServerLogs(logs []*vpostgresql.CloudPostgresqlDbServerLog, fileNames []string) ([]*vpostgresql.CloudPostgresqlDbServerLog, []string) {
+	logMap := make(map[string]*vpostgresql.CloudPostgresqlDbServerLog)
+	for _, log := range logs {
+		if log != nil && log.FileName != nil {
+			logMap[*log.FileName] = log
+		}
+	}
+
+	var filtered []*vpostgresql.CloudPostgresqlDbServerLog
+	var missing []string
+	for _, name := range fileNames {
+		log, exists := logMap[name]
+		if !exists {
+			missing = append(missing, name)
+			continue
+		}
+		filtered = append(filtered, log)
+	}
+
+	return filtered, missing
+}
+
+// postgresqlLogFileNamesChanged reports whether log files were added or removed since the export.
+func postgresqlLogFileNamesChanged(logs []*vpostgresql.CloudPostgresqlDbServerLog, exported types.List) bool {
+	exportedNames := make(map[string]bool)
+	for _, v := range exported.Elements() {
+		exportedNames[v.(types.Object).Attributes()["file_name"].(types.String).ValueString()] = true
+	}
+
+	names := make(map[string]bool)
+	for _, log := range logs {
+		if log != nil && log.FileName != nil {
+			names[*log.FileName] = true
+		}
+	}
+
+	if len(names) != len(exportedNames) {
+		return true
+	}
+
+	for name := range names {
+		if !exportedNames[name] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// changedPostgresqlDbServerLogs returns the log files that are not exported yet, or whose size or date changed since the export.
+func changedPostgresqlDbServerLogs(logs []*vpostgresql.CloudPostgresqlDbServerLog, exported types.List) []*vpostgresql.CloudPostgresqlDbServerLog {
+	exportedLogs := make(map[string]postgresqlExportedLog)
+	for _, v := range exported.Elements() {
+		attrs := v.(types.Object).Attributes()
+		exportedLogs[attrs["file_name"].(types.String).ValueString()] = postgresqlExportedLog{
+			FileSize: attrs["file_size"].(types.Int64),
+			FileDate: attrs["file_date"].(types.String),
+		}
+	}
+
+	var changed []*vpostgresql.CloudPostgresqlDbServerLog
+	for _, log := range logs {
+		if log == nil || log.FileName == nil {
+			continue
+		}
+
+		previous, ok := exportedLogs[*log.FileName]
+		if !ok || !previous.FileSize.Equal(types.Int64PointerValue(log.FileSize)) || !previous.FileDate.Equal(types.StringPointerValue(log.FileDate)) {
+			changed = append(changed, log)
+		}
+	}
+
+	return changed
+}
+
+type postgresqlLogExportResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	PostgresqlServerInstanceNo types.String `tfsdk:"postgresql_server_instance_no"`
+	LogType                    types.String `tfsdk:"log_type"`
+	BucketName                 types.String `tfsdk:"bucket_name"`
+	FileNames                  types.List   `tfsdk:"file_names"`
+	Triggers                   types.Map    `tfsdk:"triggers"`
+	ExportedFileList           types.List   `tfsdk:"exported_file_list"`
+}
+
+type postgresqlExportedLog struct {
+	FileName types.String `tfsdk:"file_name"`
+	FileSize types.Int64  `tfsdk:"file_size"`
+	FileDate types.String `tfsdk:"file_date"`
+}
+
+func (r postgresqlExportedLog) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"file_name": types.StringType,
+		"file_size": types.Int64Type,
+		"file_date": types.StringType,
+	}
+}
+
+func (r *postgresqlLogExportResourceModel) refreshFromOutput(ctx context.Context, output []*vpostgresql.CloudPostgresqlDbServerLog) diag.Diagnostics {
+	r.ID = types.StringValue(fmt.Sprintf("%s:%s", r.PostgresqlServerInstanceNo.ValueString(), r.LogType.ValueString()))
+
+	logList := []postgresqlExportedLog{}
+	for _, log := range output {
+		logList = append(logList, postgresqlExportedLog{
+			FileName: types.StringPointerValue(log.FileName),
+			FileSize: types.Int64PointerValue(log.FileSize),
+			FileDate: types.StringPointerValue(log.FileDate),
+		})
+	}
+
+	exportedFiles, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: postgresqlExportedLog{}.attrTypes()}, logList)
+	if diags.HasError() {
+		return diags
+	}
+
+	r.ExportedFileList = exportedFiles
+
+	return diags
+}
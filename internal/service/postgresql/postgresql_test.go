@@ -38,6 +38,8 @@ func TestAccResourceNcloudPostgresql_vpc_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ha", "true"),
 					resource.TestCheckResourceAttr(resourceName, "multi_zone", "false"),
 					resource.TestCheckResourceAttr(resourceName, "backup", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "writer_endpoint"),
+					resource.TestMatchResourceAttr(resourceName, "connection_uri", regexp.MustCompile(`^postgresql://`)),
 				),
 			},
 		},
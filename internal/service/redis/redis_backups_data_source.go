@@ -0,0 +1,263 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+)
+
+var (
+	_ datasource.DataSource              = &redisBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &redisBackupsDataSource{}
+)
+
+func NewRedisBackupsDataSource() datasource.DataSource {
+	return &redisBackupsDataSource{}
+}
+
+type redisBackupsDataSource struct {
+	config *conn.ProviderConfig
+}
+
+func (d *redisBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redis_backups"
+}
+
+func (d *redisBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.config = config
+}
+
+func (d *redisBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("redis_instance_no"),
+					),
+				},
+			},
+			"redis_instance_no": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("id"),
+					),
+				},
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional: true,
+			},
+			"output_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"redis_backup_list": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"backup_size": schema.Int64Attribute{
+							Computed: true,
+						},
+						"data_storage_size": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": common.DataSourceFiltersBlock(),
+		},
+	}
+}
+
+func (d *redisBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data redisBackupsDataSourceModel
+	var redisId string
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ID.IsNull() && !data.ID.IsUnknown() {
+		redisId = data.ID.ValueString()
+	}
+
+	if !data.RedisInstanceNo.IsNull() && !data.RedisInstanceNo.IsUnknown() {
+		redisId = data.RedisInstanceNo.ValueString()
+	}
+
+	output, err := GetRedisBackupDetailList(ctx, d.config, redisId)
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	redisBackupList := flattenRedisBackups(output)
+	fillteredList := common.FilterModels(ctx, data.Filters, redisBackupList)
+
+	if data.MostRecent.ValueBool() {
+		fillteredList = common.MostRecent(fillteredList, func(b *redisBackup) *string {
+			return b.EndTime.ValueStringPointer()
+		})
+	}
+
+	if diags := data.refreshFromOutput(ctx, fillteredList, redisId); diags.HasError() {
+		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+		return
+	}
+
+	if !data.OutputFile.IsNull() && data.OutputFile.String() != "" {
+		outputPath := data.OutputFile.ValueString()
+
+		if convertedList, err := convertBackupsToJsonStruct(data.RedisBackupList.Elements()); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		} else if err := common.WriteToFile(outputPath, convertedList); err != nil {
+			resp.Diagnostics.AddError("OUTPUT FILE ERROR", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func GetRedisBackupDetailList(ctx context.Context, config *conn.ProviderConfig, id string) ([]*vredis.CloudRedisBackupDetail, error) {
+	reqParams := &vredis.GetCloudRedisBackupDetailListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudRedisInstanceNo: ncloud.String(id),
+	}
+	tflog.Info(ctx, "GetRedisBackupDetailList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vredis.V2Api.GetCloudRedisBackupDetailList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "GetRedisBackupDetailList response="+common.MarshalUncheckedString(resp))
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	return resp.CloudRedisBackupDetailList, nil
+}
+
+type redisBackupsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	RedisInstanceNo types.String `tfsdk:"redis_instance_no"`
+	MostRecent      types.Bool   `tfsdk:"most_recent"`
+	RedisBackupList types.List   `tfsdk:"redis_backup_list"`
+	OutputFile      types.String `tfsdk:"output_file"`
+	Filters         types.Set    `tfsdk:"filter"`
+}
+
+type redisBackup struct {
+	StartTime       types.String `tfsdk:"start_time"`
+	EndTime         types.String `tfsdk:"end_time"`
+	BackupSize      types.Int64  `tfsdk:"backup_size"`
+	DataStorageSize types.Int64  `tfsdk:"data_storage_size"`
+}
+
+type redisBackupToJsonConvert struct {
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	BackupSize      int64  `json:"backup_size"`
+	DataStorageSize int64  `json:"data_storage_size"`
+}
+
+func (r redisBackup) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"start_time":        types.StringType,
+		"end_time":          types.StringType,
+		"backup_size":       types.Int64Type,
+		"data_storage_size": types.Int64Type,
+	}
+}
+
+func convertBackupsToJsonStruct(backups []attr.Value) ([]redisBackupToJsonConvert, error) {
+	var backupToConvert = []redisBackupToJsonConvert{}
+
+	for _, backup := range backups {
+		backupJson := redisBackupToJsonConvert{}
+		if err := json.Unmarshal([]byte(backup.String()), &backupJson); err != nil {
+			return nil, err
+		}
+		backupToConvert = append(backupToConvert, backupJson)
+	}
+
+	return backupToConvert, nil
+}
+
+func flattenRedisBackups(list []*vredis.CloudRedisBackupDetail) []*redisBackup {
+	var outputs []*redisBackup
+
+	for _, v := range list {
+		var output redisBackup
+		output.refreshFromOutput(v)
+
+		outputs = append(outputs, &output)
+	}
+	return outputs
+}
+
+func (d *redisBackup) refreshFromOutput(output *vredis.CloudRedisBackupDetail) {
+	d.StartTime = types.StringPointerValue(output.StartTime)
+	d.EndTime = types.StringPointerValue(output.EndTime)
+	d.BackupSize = types.Int64PointerValue(output.BackupSize)
+	d.DataStorageSize = types.Int64PointerValue(output.DataStorageSize)
+}
+
+func (d *redisBackupsDataSourceModel) refreshFromOutput(ctx context.Context, output []*redisBackup, instance string) diag.Diagnostics {
+	d.ID = types.StringValue(instance)
+	d.RedisInstanceNo = types.StringValue(instance)
+	backupListValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: redisBackup{}.attrTypes()}, output)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.RedisBackupList = backupListValue
+
+	return diags
+}
@@ -0,0 +1,519 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vredis"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/common"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/framework"
+)
+
+var (
+	_ resource.Resource                = &redisUsersResource{}
+	_ resource.ResourceWithConfigure   = &redisUsersResource{}
+	_ resource.ResourceWithImportState = &redisUsersResource{}
+)
+
+func NewRedisUsersResource() resource.Resource {
+	return &redisUsersResource{}
+}
+
+type redisUsersResource struct {
+	config *conn.ProviderConfig
+}
+
+func (r *redisUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var plan redisUsersResourceModel
+	var userList []redisResourceUser
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) < 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id:name1:name2:... Got: %q", req.ID),
+		)
+		return
+	}
+
+	for idx, v := range idParts {
+		if idx == 0 {
+			plan.ID = types.StringValue(v)
+			plan.RedisInstanceNo = types.StringValue(v)
+		} else {
+			user := redisResourceUser{
+				UserName:     types.StringValue(v),
+				UserPassword: types.StringNull(),
+			}
+			userList = append(userList, user)
+		}
+	}
+
+	redisUsers, _ := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: redisResourceUser{}.attrTypes()}, userList)
+	plan.RedisUserList = redisUsers
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *redisUsersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*conn.ProviderConfig)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = config
+}
+
+func (r *redisUsersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redis_users"
+}
+
+func (r *redisUsersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"redis_instance_no": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// There is no API to change a user, so a user whose password changes is deleted and added again.
+			"redis_user_list": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(4, 16),
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^[a-zA-Z]+[a-zA-Z0-9-\\_,]+$`),
+									"Composed of alphabets, numbers, hyphen (-), (\\), (_), (,). Must start with an alphabetic character.",
+								),
+							},
+						},
+						"password": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+							Validators: []validator.String{
+								stringvalidator.All(
+									stringvalidator.LengthBetween(8, 20),
+									stringvalidator.RegexMatches(regexp.MustCompile(`[a-zA-Z]+`), "Must have at least one alphabet"),
+									stringvalidator.RegexMatches(regexp.MustCompile(`\d+`), "Must have at least one number"),
+									stringvalidator.RegexMatches(regexp.MustCompile(`[~!@#$%^*()\-_=\[\]\{\};:,.<>?]+`), "Must have at least one special character"),
+									stringvalidator.RegexMatches(regexp.MustCompile(`^[^&+\\"'/\s`+"`"+`]*$`), "Must not have ` & + \\ \" ' / and white space."),
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *redisUsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan redisUsersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := waitRedisCreated(ctx, r.config, plan.RedisInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR REDIS CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vredis.AddCloudRedisUserListRequest{
+		RegionCode:           &r.config.RegionCode,
+		CloudRedisInstanceNo: plan.RedisInstanceNo.ValueStringPointer(),
+		CloudRedisUserList:   convertToCloudRedisUserParameter(plan.RedisUserList),
+	}
+
+	response, err := r.config.Client.Vredis.V2Api.AddCloudRedisUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("CREATING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "CreateRedisUserList response="+common.MarshalUncheckedString(response))
+
+	if response == nil || *response.ReturnCode != "0" {
+		resp.Diagnostics.AddError("CREATING ERROR", "response invalid")
+		return
+	}
+
+	_, err = waitRedisCreated(ctx, r.config, plan.RedisInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR REDIS CREATION ERROR", err.Error())
+		return
+	}
+
+	output, err := GetRedisUserList(ctx, r.config, plan.RedisInstanceNo.ValueString(), common.ConvertToStringList(plan.RedisUserList, "name"))
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if diags := plan.refreshFromOutput(ctx, output, plan); diags.HasError() {
+		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *redisUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state redisUsersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := GetRedisUserList(ctx, r.config, state.RedisInstanceNo.ValueString(), common.ConvertToStringList(state.RedisUserList, "name"))
+	if err != nil {
+		resp.Diagnostics.AddError("READING ERROR", err.Error())
+		return
+	}
+
+	if output == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if diags := state.refreshFromOutput(ctx, output, state); diags.HasError() {
+		resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *redisUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state redisUsersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RedisUserList.Equal(state.RedisUserList) {
+		added, removed := diffRedisUserList(plan.RedisUserList, state.RedisUserList)
+
+		if len(removed) > 0 {
+			_, err := waitRedisCreated(ctx, r.config, state.RedisInstanceNo.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("WAITING FOR REDIS CREATION ERROR", err.Error())
+				return
+			}
+
+			reqParams := &vredis.DeleteCloudRedisUserListRequest{
+				RegionCode:           &r.config.RegionCode,
+				CloudRedisInstanceNo: state.RedisInstanceNo.ValueStringPointer(),
+				CloudRedisUserList:   removed,
+			}
+			tflog.Info(ctx, "DeleteRedisUserList reqParams="+common.MarshalUncheckedString(reqParams))
+
+			response, err := r.config.Client.Vredis.V2Api.DeleteCloudRedisUserList(reqParams)
+			if err != nil {
+				resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+				return
+			}
+			tflog.Info(ctx, "DeleteRedisUserList response="+common.MarshalUncheckedString(response))
+
+			if response == nil || *response.ReturnCode != "0" {
+				resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
+				return
+			}
+		}
+
+		if len(added) > 0 {
+			_, err := waitRedisCreated(ctx, r.config, state.RedisInstanceNo.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("WAITING FOR REDIS CREATION ERROR", err.Error())
+				return
+			}
+
+			reqParams := &vredis.AddCloudRedisUserListRequest{
+				RegionCode:           &r.config.RegionCode,
+				CloudRedisInstanceNo: state.RedisInstanceNo.ValueStringPointer(),
+				CloudRedisUserList:   added,
+			}
+
+			response, err := r.config.Client.Vredis.V2Api.AddCloudRedisUserList(reqParams)
+			if err != nil {
+				resp.Diagnostics.AddError("UPDATE ERROR", err.Error())
+				return
+			}
+			tflog.Info(ctx, "AddRedisUserList response="+common.MarshalUncheckedString(response))
+
+			if response == nil || *response.ReturnCode != "0" {
+				resp.Diagnostics.AddError("UPDATE ERROR", "response invalid")
+				return
+			}
+		}
+
+		_, err := waitRedisCreated(ctx, r.config, state.RedisInstanceNo.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("WAITING FOR UPDATE ERROR", err.Error())
+			return
+		}
+
+		output, err := GetRedisUserList(ctx, r.config, state.RedisInstanceNo.ValueString(), common.ConvertToStringList(plan.RedisUserList, "name"))
+		if err != nil {
+			resp.Diagnostics.AddError("READING ERROR", err.Error())
+			return
+		}
+
+		if output == nil {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		if diags := state.refreshFromOutput(ctx, output, plan); diags.HasError() {
+			resp.Diagnostics.AddError("READING ERROR", "refreshFromOutput error")
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *redisUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state redisUsersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := waitRedisCreated(ctx, r.config, state.RedisInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR REDIS CREATION ERROR", err.Error())
+		return
+	}
+
+	reqParams := &vredis.DeleteCloudRedisUserListRequest{
+		RegionCode:           &r.config.RegionCode,
+		CloudRedisInstanceNo: state.RedisInstanceNo.ValueStringPointer(),
+		CloudRedisUserList:   convertToCloudRedisUserKeyParameter(state.RedisUserList),
+	}
+	tflog.Info(ctx, "DeleteRedisUserList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	response, err := r.config.Client.Vredis.V2Api.DeleteCloudRedisUserList(reqParams)
+	if err != nil {
+		resp.Diagnostics.AddError("DELETING ERROR", err.Error())
+		return
+	}
+	tflog.Info(ctx, "DeleteRedisUserList response="+common.MarshalUncheckedString(response))
+
+	_, err = waitRedisCreated(ctx, r.config, state.RedisInstanceNo.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("WAITING FOR DELETE ERROR", err.Error())
+		return
+	}
+}
+
+func GetRedisUserList(ctx context.Context, config *conn.ProviderConfig, id string, users []string) ([]*vredis.CloudRedisUser, error) {
+	reqParams := &vredis.GetCloudRedisUserListRequest{
+		RegionCode:           &config.RegionCode,
+		CloudRedisInstanceNo: ncloud.String(id),
+	}
+	tflog.Info(ctx, "GetRedisUserList reqParams="+common.MarshalUncheckedString(reqParams))
+
+	resp, err := config.Client.Vredis.V2Api.GetCloudRedisUserList(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil {
+		return nil, nil
+	}
+
+	userMap := make(map[string]*vredis.CloudRedisUser)
+	for _, user := range resp.CloudRedisUserList {
+		if user != nil && user.UserName != nil {
+			userMap[*user.UserName] = user
+		}
+	}
+
+	var filteredUsers []*vredis.CloudRedisUser
+	for _, username := range users {
+		if user, exists := userMap[username]; exists {
+			filteredUsers = append(filteredUsers, user)
+		}
+	}
+
+	if len(filteredUsers) == 0 {
+		return nil, nil
+	}
+
+	tflog.Info(ctx, "GetRedisUserList response="+common.MarshalUncheckedString(filteredUsers))
+
+	return filteredUsers, nil
+}
+
+type redisUsersResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	RedisInstanceNo types.String `tfsdk:"redis_instance_no"`
+	RedisUserList   types.List   `tfsdk:"redis_user_list"`
+}
+
+type redisResourceUser struct {
+	UserName     types.String `tfsdk:"name"`
+	UserPassword types.String `tfsdk:"password"`
+}
+
+func (r redisResourceUser) attrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":     types.StringType,
+		"password": types.StringType,
+	}
+}
+
+func (r *redisUsersResourceModel) refreshFromOutput(ctx context.Context, output []*vredis.CloudRedisUser, resourceModel redisUsersResourceModel) diag.Diagnostics {
+	r.ID = resourceModel.RedisInstanceNo
+	r.RedisInstanceNo = resourceModel.RedisInstanceNo
+
+	// The API does not return passwords, so they are carried over from the configuration by name.
+	passwords := make(map[string]types.String)
+	for _, v := range resourceModel.RedisUserList.Elements() {
+		attrs := v.(types.Object).Attributes()
+		passwords[attrs["name"].(types.String).ValueString()] = attrs["password"].(types.String)
+	}
+
+	var userList []redisResourceUser
+	for _, user := range output {
+		password, ok := passwords[*user.UserName]
+		if !ok {
+			password = types.StringNull()
+		}
+
+		userList = append(userList, redisResourceUser{
+			UserName:     types.StringPointerValue(user.UserName),
+			UserPassword: password,
+		})
+	}
+
+	redisUsers, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: redisResourceUser{}.attrTypes()}, userList)
+	if diags.HasError() {
+		return diags
+	}
+
+	r.RedisUserList = redisUsers
+
+	return diags
+}
+
+func convertToCloudRedisUserParameter(values basetypes.ListValue) []*vredis.CloudRedisUserParameter {
+	result := make([]*vredis.CloudRedisUserParameter, 0, len(values.Elements()))
+
+	for _, v := range values.Elements() {
+		attrs := v.(types.Object).Attributes()
+
+		result = append(result, &vredis.CloudRedisUserParameter{
+			Name:     attrs["name"].(types.String).ValueStringPointer(),
+			Password: attrs["password"].(types.String).ValueStringPointer(),
+		})
+	}
+
+	return result
+}
+
+func convertToCloudRedisUserKeyParameter(values basetypes.ListValue) []*vredis.CloudRedisUserKeyParameter {
+	result := make([]*vredis.CloudRedisUserKeyParameter, 0, len(values.Elements()))
+
+	for _, v := range values.Elements() {
+		attrs := v.(types.Object).Attributes()
+
+		result = append(result, &vredis.CloudRedisUserKeyParameter{
+			Name: attrs["name"].(types.String).ValueStringPointer(),
+		})
+	}
+
+	return result
+}
+
+// diffRedisUserList returns the users to add and to delete by name.
+// A user whose password changed is deleted and added again, as there is no API to change a user.
+func diffRedisUserList(plan, state basetypes.ListValue) ([]*vredis.CloudRedisUserParameter, []*vredis.CloudRedisUserKeyParameter) {
+	current := make(map[string]types.String)
+	for _, v := range state.Elements() {
+		attrs := v.(types.Object).Attributes()
+		current[attrs["name"].(types.String).ValueString()] = attrs["password"].(types.String)
+	}
+
+	var added []*vredis.CloudRedisUserParameter
+	var removed []*vredis.CloudRedisUserKeyParameter
+
+	planned := make(map[string]bool)
+	for _, v := range plan.Elements() {
+		attrs := v.(types.Object).Attributes()
+		name := attrs["name"].(types.String)
+		password := attrs["password"].(types.String)
+		planned[name.ValueString()] = true
+
+		if statePassword, ok := current[name.ValueString()]; ok {
+			// imported users have no password in state, and are kept as they are
+			if statePassword.IsNull() || statePassword.Equal(password) {
+				continue
+			}
+
+			removed = append(removed, &vredis.CloudRedisUserKeyParameter{
+				Name: name.ValueStringPointer(),
+			})
+		}
+
+		added = append(added, &vredis.CloudRedisUserParameter{
+			Name:     name.ValueStringPointer(),
+			Password: password.ValueStringPointer(),
+		})
+	}
+
+	for _, v := range state.Elements() {
+		name := v.(types.Object).Attributes()["name"].(types.String)
+		if !planned[name.ValueString()] {
+			removed = append(removed, &vredis.CloudRedisUserKeyParameter{
+				Name: name.ValueStringPointer(),
+			})
+		}
+	}
+
+	return added, removed
+}
@@ -0,0 +1,115 @@
+package redis_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	. "github.com/terraform-providers/terraform-provider-ncloud/internal/acctest"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/conn"
+	redisservice "github.com/terraform-providers/terraform-provider-ncloud/internal/service/redis"
+)
+
+func TestAccResourceNcloudRedisUsers_vpc_basic(t *testing.T) {
+	testName := fmt.Sprintf("tf-redisuser-%s", acctest.RandString(5))
+	resourceName := "ncloud_redis_users.redis_users"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRedisUsersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisUsersConfig(testName, "testuser2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "redis_instance_no", "ncloud_redis.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "redis_user_list.0.name", "testuser1"),
+					resource.TestCheckResourceAttr(resourceName, "redis_user_list.1.name", "testuser2"),
+				),
+			},
+			{
+				Config: testAccRedisUsersConfig(testName, "testuser3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "redis_user_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "redis_user_list.0.name", "testuser1"),
+					resource.TestCheckResourceAttr(resourceName, "redis_user_list.1.name", "testuser3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedisUsersConfig(testRedisName, secondUserName string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "test_vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.5.0.0/16"
+}
+
+resource "ncloud_subnet" "test_subnet" {
+	vpc_no             = ncloud_vpc.test_vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.5.0.0/24"
+	zone               = "KR-1"
+	network_acl_no     = ncloud_vpc.test_vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+}
+
+resource "ncloud_redis_config_group" "example" {
+    name               = "%[1]s"
+    redis_version      = "7.0.13-simple"
+    description        = "example"
+}
+
+resource "ncloud_redis" "test" {
+    service_name        = "%[1]s"
+    server_name_prefix  = "ex-svr"
+	vpc_no              = ncloud_vpc.test_vpc.vpc_no
+    subnet_no           = ncloud_subnet.test_subnet.id
+    config_group_no     = ncloud_redis_config_group.example.id
+	image_product_code  = "SW.VRDS.OS.LNX64.ROCKY.0810.REDIS.B050"
+	engine_version_code = "7.0.13"
+    mode = "SIMPLE"
+}
+
+resource "ncloud_redis_users" "redis_users" {
+	redis_instance_no = ncloud_redis.test.id
+	redis_user_list = [
+		{
+			name = "testuser1",
+			password = "t123456789!"
+		},
+		{
+			name = "%[2]s",
+			password = "t123456789!"
+		}
+	]
+}
+`, testRedisName, secondUserName)
+}
+
+func testAccCheckRedisUsersDestroy(s *terraform.State) error {
+	config := TestAccProvider.Meta().(*conn.ProviderConfig)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ncloud_redis_users" {
+			continue
+		}
+
+		users, err := redisservice.GetRedisUserList(context.Background(), config, rs.Primary.ID, []string{"testuser1", "testuser2", "testuser3"})
+		if err != nil {
+			continue
+		}
+
+		if len(users) > 0 {
+			return errors.New("redis users still exists")
+		}
+	}
+
+	return nil
+}
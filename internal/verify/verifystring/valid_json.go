@@ -0,0 +1,38 @@
+package verifystring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type validJSONValidator struct{}
+
+func ValidJSON() validator.String {
+	return validJSONValidator{}
+}
+
+func (v validJSONValidator) Description(ctx context.Context) string {
+	return "Value must be a valid JSON document."
+}
+
+func (v validJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validJSONValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var js interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &js); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("Value must be a valid JSON document: %s", err),
+		)
+	}
+}
@@ -0,0 +1,58 @@
+package verifystring_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-providers/terraform-provider-ncloud/internal/verify/verifystring"
+)
+
+func TestValidJSONValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in        types.String
+		expErrors int
+	}
+
+	testCases := map[string]testCase{
+		"valid-object": {
+			in:        types.StringValue(`{"Version": "2012-10-17", "Statement": []}`),
+			expErrors: 0,
+		},
+		"invalid-json": {
+			in:        types.StringValue(`{"Version": "2012-10-17",`),
+			expErrors: 1,
+		},
+		"skip-validation-on-null": {
+			in:        types.StringNull(),
+			expErrors: 0,
+		},
+		"skip-validation-on-unknown": {
+			in:        types.StringUnknown(),
+			expErrors: 0,
+		},
+	}
+
+	for name, test := range testCases {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := validator.StringRequest{
+				ConfigValue: test.in,
+			}
+			res := validator.StringResponse{}
+			verifystring.ValidJSON().ValidateString(context.TODO(), req, &res)
+
+			if test.expErrors > 0 && test.expErrors != res.Diagnostics.ErrorsCount() {
+				t.Fatalf("expected %d error(s), got %d: %v", test.expErrors, res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+
+			if test.expErrors == 0 && res.Diagnostics.HasError() {
+				t.Fatalf("expected no error(s), got %d: %v", res.Diagnostics.ErrorsCount(), res.Diagnostics)
+			}
+		})
+	}
+}